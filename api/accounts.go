@@ -46,6 +46,50 @@ func (api *API) GetAccount(w http.ResponseWriter, r *http.Request) {
 	jsonData(w, resp)
 }
 
+func (api *API) GetAccountUnbonding(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || address == "" || len(address) != 62 {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	resp, err := api.svc.GetAccountUnbonding(address)
+	if err != nil {
+		log.Error("API GetAccountUnbonding: svc.GetAccountUnbonding: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetBalanceHistory(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || address == "" || len(address) != 62 {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	var filter filters.BalanceHistory
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetBalanceHistory: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.Address = address
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetBalanceHistory: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetBalanceHistory(filter)
+	if err != nil {
+		log.Error("API GetBalanceHistory: svc.GetBalanceHistory: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
 func (api *API) GetESDTAccounts(w http.ResponseWriter, r *http.Request) {
 	var filter filters.ESDT
 	err := api.queryDecoder.Decode(&filter, r.URL.Query())
@@ -69,3 +113,34 @@ func (api *API) GetESDTAccounts(w http.ResponseWriter, r *http.Request) {
 	}
 	jsonData(w, resp)
 }
+
+func (api *API) GetAccountNFTs(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || address == "" || len(address) != 62 {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	var filter filters.ESDT
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetAccountNFTs: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.Address = address
+	filter.TokenIdentifier = r.URL.Query().Get("collection")
+	filter.SetMaxLimit(100)
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetAccountNFTs: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetAccountNFTs(filter)
+	if err != nil {
+		log.Error("API GetAccountNFTs: svc.GetAccountNFTs: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
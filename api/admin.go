@@ -0,0 +1,24 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+)
+
+const adminKeyHeader = "X-Admin-Key"
+
+// adminAuth allows the request only with the admin key from the config
+func (api *API) adminAuth(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	key := r.Header.Get(adminKeyHeader)
+	if api.cfg.API.AdminKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(api.cfg.API.AdminKey)) != 1 {
+		bytes, _ := json.Marshal(errResponse{
+			Error: "forbidden",
+		})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write(bytes)
+		return
+	}
+	next(w, r)
+}
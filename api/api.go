@@ -95,8 +95,8 @@ func (api *API) loadRoutes() {
 	wrapper.Use(cors.New(cors.Options{
 		AllowedOrigins:   api.cfg.API.CORSAllowedOrigins,
 		AllowCredentials: true,
-		AllowedMethods:   []string{"POST", "GET", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Sec-Fetch-Mode"},
+		AllowedMethods:   []string{"POST", "GET", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Sec-Fetch-Mode", adminKeyHeader},
 	}))
 
 	// public
@@ -112,34 +112,85 @@ func (api *API) loadRoutes() {
 		{Path: "/block/{shard}/{nonce}", Method: http.MethodGet, Func: api.GetBlockByNonce},
 		{Path: "/accounts", Method: http.MethodGet, Func: api.GetAccounts},
 		{Path: "/account/{address}", Method: http.MethodGet, Func: api.GetAccount},
+		{Path: "/account/{address}/balance/history", Method: http.MethodGet, Func: api.GetBalanceHistory},
+		{Path: "/account/{address}/nfts", Method: http.MethodGet, Func: api.GetAccountNFTs},
+		{Path: "/account/{address}/unbonding", Method: http.MethodGet, Func: api.GetAccountUnbonding},
+		{Path: "/account/{address}/stake/history", Method: http.MethodGet, Func: api.GetAccountStakeHistory},
 		{Path: "/miniblock/{hash}", Method: http.MethodGet, Func: api.GetMiniBlock},
 		{Path: "/stats", Method: http.MethodGet, Func: api.GetStats},
+		{Path: "/stats/distribution", Method: http.MethodGet, Func: api.GetDistribution},
 		{Path: "/transactions/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.TotalTransactionsKey)},
 		{Path: "/accounts/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.TotalAccountKey)},
 		{Path: "/price/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.PriceKey)},
 		{Path: "/stake/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.TotalStakeKey)},
+		{Path: "/stake/sources/range", Method: http.MethodGet, Func: api.GetStakeSourcesHistory},
 		{Path: "/delegators/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.TotalDelegatorsKey)},
+		{Path: "/holders/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.HoldersKey)},
+		{Path: "/gini/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.GiniKey)},
+		{Path: "/fees/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.FeesKey)},
+		{Path: "/fees/median/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.MedianFeeKey)},
+		{Path: "/fees/p90/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.P90FeeKey)},
+		{Path: "/fees/shard/{shard}/range", Method: http.MethodGet, Func: api.GetShardFeesRange},
+		{Path: "/fees/contract/{address}/range", Method: http.MethodGet, Func: api.GetContractFeesRange},
+		{Path: "/fees/contracts", Method: http.MethodGet, Func: api.GetTopFeeContracts},
+		{Path: "/gas/efficiency/range", Method: http.MethodGet, Func: api.GetDailyStats(dailystats.GasEfficiencyKey)},
+		{Path: "/tps", Method: http.MethodGet, Func: api.GetTPS},
+		{Path: "/tps/range", Method: http.MethodGet, Func: api.GetThroughputRange},
+		{Path: "/shards/throughput", Method: http.MethodGet, Func: api.GetShardsThroughput},
 		{Path: "/epoch", Method: http.MethodGet, Func: api.GetEpoch},
+		{Path: "/epochs", Method: http.MethodGet, Func: api.GetEpochs},
+		{Path: "/epoch/{number}", Method: http.MethodGet, Func: api.GetEpochSummary},
 		{Path: "/validators/map", Method: http.MethodGet, Func: api.GetValidatorsMap},
 		{Path: "/stake/events", Method: http.MethodGet, Func: api.GetStakeEvents},
 		{Path: "/staking/providers", Method: http.MethodGet, Func: api.GetStakingProviders},
 		{Path: "/staking/provider/{address}", Method: http.MethodGet, Func: api.GetStakingProvider},
+		{Path: "/staking/provider/{address}/flows", Method: http.MethodGet, Func: api.GetProviderStakeFlows},
+		{Path: "/staking/provider/{address}/delegators", Method: http.MethodGet, Func: api.GetProviderDelegators},
+		{Path: "/staking/flows", Method: http.MethodGet, Func: api.GetStakeFlows},
+		{Path: "/staking/delegations/drifts", Method: http.MethodGet, Func: api.GetDelegationDrifts},
+		{Path: "/staking/unbonding", Method: http.MethodGet, Func: api.GetUnbondingStats},
+		{Path: "/staking/calculator", Method: http.MethodGet, Func: api.GetStakingCalculation},
 		{Path: "/nodes", Method: http.MethodGet, Func: api.GetNodes},
 		{Path: "/node/{key}", Method: http.MethodGet, Func: api.GetNode},
+		{Path: "/nodes/queue", Method: http.MethodGet, Func: api.GetQueue},
 		{Path: "/validators", Method: http.MethodGet, Func: api.GetValidators},
 		{Path: "/validator/{identity}", Method: http.MethodGet, Func: api.GetValidator},
 		{Path: "/stats/validators", Method: http.MethodGet, Func: api.GetValidatorStats},
 		{Path: "/providers/ranking", Method: http.MethodGet, Func: api.GetRanking},
+		{Path: "/providers/ranking/history", Method: http.MethodGet, Func: api.GetRankingHistory},
 		{Path: "/operations", Method: http.MethodGet, Func: api.GetOperations},
 		{Path: "/esdt/accounts", Method: http.MethodGet, Func: api.GetESDTAccounts},
 
 		// tokens
 		{Path: "/token/{identifier}", Method: http.MethodGet, Func: api.GetToken},
+		{Path: "/token/{identifier}/holders", Method: http.MethodGet, Func: api.GetTokenHolders},
+		{Path: "/token/{identifier}/holders/range", Method: http.MethodGet, Func: api.GetTokenDailyStats(dailystats.TokenHoldersKey)},
+		{Path: "/token/{identifier}/transfers/range", Method: http.MethodGet, Func: api.GetTokenDailyStats(dailystats.TokenTransfersKey)},
+		{Path: "/token/{identifier}/volume/range", Method: http.MethodGet, Func: api.GetTokenDailyStats(dailystats.TokenVolumeKey)},
+		{Path: "/token/{identifier}/supply/range", Method: http.MethodGet, Func: api.GetTokenSupplyRange},
+		{Path: "/token/{identifier}/mints", Method: http.MethodGet, Func: api.GetTokenMints},
 		{Path: "/tokens", Method: http.MethodGet, Func: api.GetTokens},
+		{Path: "/tokens/sync", Method: http.MethodGet, Func: api.GetTokensSync},
 		{Path: "/nft/collection/{identifier}", Method: http.MethodGet, Func: api.GetNFTCollection},
+		{Path: "/nft/collection/{identifier}/stats", Method: http.MethodGet, Func: api.GetNFTCollectionStats},
 		{Path: "/nft/collections", Method: http.MethodGet, Func: api.GetNFTCollections},
 		{Path: "/nft/{identifier}", Method: http.MethodGet, Func: api.GetNFT},
+		{Path: "/nft/{identifier}/thumbnail", Method: http.MethodGet, Func: api.GetNFTThumbnail},
+		{Path: "/nft/{identifier}/transfers", Method: http.MethodGet, Func: api.GetNFTTransfers},
 		{Path: "/nfts", Method: http.MethodGet, Func: api.GetNFTs},
+
+		// contracts
+		{Path: "/contracts", Method: http.MethodGet, Func: api.GetContracts},
+		{Path: "/contract/{address}", Method: http.MethodGet, Func: api.GetContract},
+		{Path: "/contract/{address}/calls", Method: http.MethodGet, Func: api.GetContractCalls},
+		{Path: "/contract/{address}/abi", Method: http.MethodGet, Func: api.GetContractABI},
+	})
+
+	// admin
+	HandleActions(api.router, wrapper, "/admin", []*Route{
+		{Path: "/contract/{address}/abi", Method: http.MethodPost, Func: api.UpdateContractABI, Middleware: []negroni.HandlerFunc{api.adminAuth}},
+		{Path: "/identity/{identity}", Method: http.MethodPost, Func: api.SetIdentityProfile, Middleware: []negroni.HandlerFunc{api.adminAuth}},
+		{Path: "/identity/{identity}", Method: http.MethodDelete, Func: api.DeleteIdentityProfile, Middleware: []negroni.HandlerFunc{api.adminAuth}},
 	})
 
 }
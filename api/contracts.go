@@ -0,0 +1,119 @@
+package api
+
+import (
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/gorilla/mux"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+const maxABISize = 1 << 20 // 1 MB
+
+func (api *API) GetContracts(w http.ResponseWriter, r *http.Request) {
+	var filter filters.Contracts
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetContracts: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.SetMaxLimit(100)
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetContracts: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetContracts(filter)
+	if err != nil {
+		log.Error("API GetContracts: svc.GetContracts: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetContract(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || address == "" || len(address) != 62 {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	resp, err := api.svc.GetContract(address)
+	if err != nil {
+		log.Error("API GetContract: svc.GetContract: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetContractCalls(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || address == "" || len(address) != 62 {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	var filter filters.ContractCalls
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetContractCalls: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.Contract = address
+	filter.SetMaxLimit(100)
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetContractCalls: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetContractCalls(filter)
+	if err != nil {
+		log.Error("API GetContractCalls: svc.GetContractCalls: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetContractABI(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || address == "" || len(address) != 62 {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	resp, err := api.svc.GetContractABI(address)
+	if err != nil {
+		log.Error("API GetContractABI: svc.GetContractABI: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) UpdateContractABI(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || address == "" || len(address) != 62 {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxABISize))
+	if err != nil {
+		log.Debug("API UpdateContractABI: ioutil.ReadAll: %s", err.Error())
+		jsonBadRequest(w, "bad body")
+		return
+	}
+	err = api.svc.UpdateContractABI(address, body)
+	if err != nil {
+		log.Error("API UpdateContractABI: svc.UpdateContractABI: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, map[string]bool{
+		"status": true,
+	})
+}
@@ -1,8 +1,11 @@
 package api
 
 import (
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
 	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/gorilla/mux"
 	"net/http"
+	"strconv"
 )
 
 func (api *API) GetEpoch(w http.ResponseWriter, r *http.Request) {
@@ -14,3 +17,42 @@ func (api *API) GetEpoch(w http.ResponseWriter, r *http.Request) {
 	}
 	jsonData(w, resp)
 }
+
+func (api *API) GetEpochs(w http.ResponseWriter, r *http.Request) {
+	var filter filters.Epochs
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetEpochs: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.SetMaxLimit(100)
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetEpochs: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetEpochs(filter)
+	if err != nil {
+		log.Error("API GetEpochs: svc.GetEpochs: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetEpochSummary(w http.ResponseWriter, r *http.Request) {
+	number, err := strconv.ParseUint(mux.Vars(r)["number"], 10, 64)
+	if err != nil {
+		jsonBadRequest(w, "invalid epoch number")
+		return
+	}
+	resp, err := api.svc.GetEpochSummary(number)
+	if err != nil {
+		log.Error("API GetEpochSummary: svc.GetEpochSummary: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/services/dailystats"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (api *API) GetShardFeesRange(w http.ResponseWriter, r *http.Request) {
+	shard, err := strconv.ParseUint(mux.Vars(r)["shard"], 10, 64)
+	if err != nil {
+		jsonBadRequest(w, "invalid shard")
+		return
+	}
+	api.GetDailyStats(fmt.Sprintf(dailystats.ShardFeesKey, shard))(w, r)
+}
+
+func (api *API) GetContractFeesRange(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || len(address) != 62 {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	api.GetDailyStats(fmt.Sprintf(dailystats.ContractFeesKey, address))(w, r)
+}
+
+func (api *API) GetTopFeeContracts(w http.ResponseWriter, r *http.Request) {
+	var filter filters.DailyStats
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetTopFeeContracts: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	if filter.From.IsZero() {
+		filter.From = smodels.NewTime(time.Now().Add(-time.Hour * 24 * 7))
+	}
+	if filter.Limit == 0 || filter.Limit > 100 {
+		filter.Limit = 100
+	}
+	resp, err := api.svc.GetTopFeeContracts(filter)
+	if err != nil {
+		log.Error("API GetTopFeeContracts: svc.GetTopFeeContracts: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
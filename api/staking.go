@@ -3,9 +3,14 @@ package api
 import (
 	"github.com/everstake/elrond-monitor-backend/dao/filters"
 	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/gorilla/mux"
 	"net/http"
+	"time"
 )
 
+const maxStakeRange = time.Hour * 24 * 366
+
 func (api *API) GetStakeEvents(w http.ResponseWriter, r *http.Request) {
 	var filter filters.StakeEvents
 	err := api.queryDecoder.Decode(&filter, r.URL.Query())
@@ -29,3 +34,151 @@ func (api *API) GetStakeEvents(w http.ResponseWriter, r *http.Request) {
 	}
 	jsonData(w, resp)
 }
+
+func (api *API) GetDelegationDrifts(w http.ResponseWriter, r *http.Request) {
+	var filter filters.DelegationDrifts
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetDelegationDrifts: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.SetMaxLimit(100)
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetDelegationDrifts: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetDelegationDrifts(filter)
+	if err != nil {
+		log.Error("API GetDelegationDrifts: svc.GetDelegationDrifts: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetUnbondingStats(w http.ResponseWriter, r *http.Request) {
+	resp, err := api.svc.GetUnbondingStats()
+	if err != nil {
+		log.Error("API GetUnbondingStats: svc.GetUnbondingStats: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetStakeSourcesHistory(w http.ResponseWriter, r *http.Request) {
+	filter, ok := api.decodeStakeSourcesFilter(w, r)
+	if !ok {
+		return
+	}
+	api.stakeSourcesHistory(w, filter)
+}
+
+func (api *API) GetAccountStakeHistory(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || address == "" || len(address) != 62 {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	filter, ok := api.decodeStakeSourcesFilter(w, r)
+	if !ok {
+		return
+	}
+	filter.Address = address
+	api.stakeSourcesHistory(w, filter)
+}
+
+func (api *API) stakeSourcesHistory(w http.ResponseWriter, filter filters.StakeSourcesRange) {
+	resp, err := api.svc.GetStakeSourcesHistory(filter)
+	if err != nil {
+		log.Error("API GetStakeSourcesHistory: svc.GetStakeSourcesHistory: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+// decodeStakeSourcesFilter decodes the filter, the range is the last 30 days by default
+func (api *API) decodeStakeSourcesFilter(w http.ResponseWriter, r *http.Request) (filter filters.StakeSourcesRange, ok bool) {
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API decodeStakeSourcesFilter: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return filter, false
+	}
+	if filter.To.IsZero() {
+		filter.To = smodels.NewTime(time.Now())
+	}
+	if filter.From.IsZero() {
+		filter.From = smodels.NewTime(filter.To.AddDate(0, 0, -30))
+	}
+	if !filter.From.Before(filter.To.Time) {
+		jsonBadRequest(w, "invalid range")
+		return filter, false
+	}
+	if filter.To.Sub(filter.From.Time) > maxStakeRange {
+		jsonBadRequest(w, "range is too long")
+		return filter, false
+	}
+	return filter, true
+}
+
+func (api *API) GetStakeFlows(w http.ResponseWriter, r *http.Request) {
+	filter, ok := api.decodeStakeFlowsFilter(w, r)
+	if !ok {
+		return
+	}
+	api.stakeFlows(w, filter)
+}
+
+func (api *API) GetProviderStakeFlows(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || address == "" {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	filter, ok := api.decodeStakeFlowsFilter(w, r)
+	if !ok {
+		return
+	}
+	filter.Provider = address
+	api.stakeFlows(w, filter)
+}
+
+func (api *API) stakeFlows(w http.ResponseWriter, filter filters.StakeFlows) {
+	resp, err := api.svc.GetStakeFlows(filter)
+	if err != nil {
+		log.Error("API GetStakeFlows: svc.GetStakeFlows: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+// decodeStakeFlowsFilter decodes the filter, the range is the last 30 days by default
+func (api *API) decodeStakeFlowsFilter(w http.ResponseWriter, r *http.Request) (filter filters.StakeFlows, ok bool) {
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API decodeStakeFlowsFilter: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return filter, false
+	}
+	if filter.To.IsZero() {
+		filter.To = smodels.NewTime(time.Now())
+	}
+	if filter.From.IsZero() {
+		filter.From = smodels.NewTime(filter.To.AddDate(0, 0, -30))
+	}
+	if !filter.From.Before(filter.To.Time) {
+		jsonBadRequest(w, "invalid range")
+		return filter, false
+	}
+	if filter.To.Sub(filter.From.Time) > maxStakeRange {
+		jsonBadRequest(w, "range is too long")
+		return filter, false
+	}
+	return filter, true
+}
@@ -18,6 +18,16 @@ func (api *API) GetStats(w http.ResponseWriter, r *http.Request) {
 	jsonData(w, resp)
 }
 
+func (api *API) GetDistribution(w http.ResponseWriter, r *http.Request) {
+	resp, err := api.svc.GetDistribution()
+	if err != nil {
+		log.Error("API GetDistribution: svc.GetDistribution: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
 func (api *API) GetDailyStats(key string) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var filter filters.DailyStats
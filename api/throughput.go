@@ -0,0 +1,74 @@
+package api
+
+import (
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"net/http"
+	"time"
+)
+
+const maxThroughputRange = time.Hour * 24
+
+func (api *API) GetTPS(w http.ResponseWriter, r *http.Request) {
+	resp, err := api.svc.GetTPS()
+	if err != nil {
+		log.Error("API GetTPS: svc.GetTPS: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetThroughputRange(w http.ResponseWriter, r *http.Request) {
+	filter, ok := api.decodeThroughputFilter(w, r)
+	if !ok {
+		return
+	}
+	if filter.To.Sub(filter.From.Time) > maxThroughputRange {
+		jsonBadRequest(w, "range is too long")
+		return
+	}
+	resp, err := api.svc.GetThroughputRange(filter)
+	if err != nil {
+		log.Error("API GetThroughputRange: svc.GetThroughputRange: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetShardsThroughput(w http.ResponseWriter, r *http.Request) {
+	filter, ok := api.decodeThroughputFilter(w, r)
+	if !ok {
+		return
+	}
+	resp, err := api.svc.GetShardsThroughput(filter)
+	if err != nil {
+		log.Error("API GetShardsThroughput: svc.GetShardsThroughput: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+// decodeThroughputFilter decodes the filter, the range is the last hour by default
+func (api *API) decodeThroughputFilter(w http.ResponseWriter, r *http.Request) (filter filters.Throughput, ok bool) {
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API decodeThroughputFilter: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return filter, false
+	}
+	if filter.To.IsZero() {
+		filter.To = smodels.NewTime(time.Now())
+	}
+	if filter.From.IsZero() {
+		filter.From = smodels.NewTime(filter.To.Add(-time.Hour))
+	}
+	if !filter.From.Before(filter.To.Time) {
+		jsonBadRequest(w, "invalid range")
+		return filter, false
+	}
+	return filter, true
+}
@@ -3,8 +3,10 @@ package api
 import (
 	"github.com/everstake/elrond-monitor-backend/dao/filters"
 	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/smodels"
 	"github.com/gorilla/mux"
 	"net/http"
+	"time"
 )
 
 func (api *API) GetToken(w http.ResponseWriter, r *http.Request) {
@@ -61,6 +63,45 @@ func (api *API) GetNFTCollection(w http.ResponseWriter, r *http.Request) {
 	jsonData(w, resp)
 }
 
+func (api *API) GetTokensSync(w http.ResponseWriter, r *http.Request) {
+	resp, err := api.svc.GetTokensSync()
+	if err != nil {
+		log.Error("API GetTokensSync: svc.GetTokensSync: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetNFTCollectionStats(w http.ResponseWriter, r *http.Request) {
+	identifier, ok := mux.Vars(r)["identifier"]
+	if !ok || identifier == "" {
+		jsonBadRequest(w, "invalid identifier")
+		return
+	}
+	var filter filters.NFTCollectionStats
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetNFTCollectionStats: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.Identifier = identifier
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetNFTCollectionStats: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetNFTCollectionStats(filter)
+	if err != nil {
+		log.Error("API GetNFTCollectionStats: svc.GetNFTCollectionStats: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
 func (api *API) GetNFTCollections(w http.ResponseWriter, r *http.Request) {
 	var filter filters.NFTCollections
 	err := api.queryDecoder.Decode(&filter, r.URL.Query())
@@ -100,6 +141,52 @@ func (api *API) GetNFT(w http.ResponseWriter, r *http.Request) {
 	jsonData(w, resp)
 }
 
+func (api *API) GetNFTTransfers(w http.ResponseWriter, r *http.Request) {
+	identifier, ok := mux.Vars(r)["identifier"]
+	if !ok || identifier == "" {
+		jsonBadRequest(w, "invalid identifier")
+		return
+	}
+	var filter filters.Operations
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetNFTTransfers: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.Token = identifier
+	filter.TxHash = ""
+	filter.SetMaxLimit(100)
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetNFTTransfers: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetNFTTransfers(filter)
+	if err != nil {
+		log.Error("API GetNFTTransfers: svc.GetNFTTransfers: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetNFTThumbnail(w http.ResponseWriter, r *http.Request) {
+	identifier, ok := mux.Vars(r)["identifier"]
+	if !ok || identifier == "" {
+		jsonBadRequest(w, "invalid identifier")
+		return
+	}
+	path, err := api.svc.GetNFTThumbnail(identifier)
+	if err != nil {
+		log.Error("API GetNFTThumbnail: svc.GetNFTThumbnail: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	http.ServeFile(w, r, path)
+}
+
 func (api *API) GetNFTs(w http.ResponseWriter, r *http.Request) {
 	var filter filters.NFTTokens
 	err := api.queryDecoder.Decode(&filter, r.URL.Query())
@@ -123,3 +210,119 @@ func (api *API) GetNFTs(w http.ResponseWriter, r *http.Request) {
 	}
 	jsonData(w, resp)
 }
+
+func (api *API) GetTokenHolders(w http.ResponseWriter, r *http.Request) {
+	identifier, ok := mux.Vars(r)["identifier"]
+	if !ok || identifier == "" {
+		jsonBadRequest(w, "invalid identifier")
+		return
+	}
+	var filter filters.ESDT
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetTokenHolders: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.TokenIdentifier = identifier
+	filter.SetMaxLimit(100)
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetTokenHolders: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetTokenHolders(filter)
+	if err != nil {
+		log.Error("API GetTokenHolders: svc.GetTokenHolders: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetTokenDailyStats(key string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		identifier, ok := mux.Vars(r)["identifier"]
+		if !ok || identifier == "" {
+			jsonBadRequest(w, "invalid identifier")
+			return
+		}
+		var filter filters.TokenDailyStats
+		err := api.queryDecoder.Decode(&filter, r.URL.Query())
+		if err != nil {
+			log.Debug("API GetTokenDailyStats: Decode: %s", err.Error())
+			jsonBadRequest(w, "bad params")
+			return
+		}
+		if filter.From.IsZero() {
+			filter.From = smodels.NewTime(time.Now().Add(-time.Hour * 24 * 7))
+		}
+		filter.Token = identifier
+		filter.Key = key
+		resp, err := api.svc.GetTokenDailyStats(filter)
+		if err != nil {
+			log.Error("API GetTokenDailyStats: svc.GetTokenDailyStats: %s", err.Error())
+			jsonError(err, w)
+			return
+		}
+		jsonData(w, resp)
+	}
+}
+
+func (api *API) GetTokenSupplyRange(w http.ResponseWriter, r *http.Request) {
+	identifier, ok := mux.Vars(r)["identifier"]
+	if !ok || identifier == "" {
+		jsonBadRequest(w, "invalid identifier")
+		return
+	}
+	var filter filters.TokenDailyStats
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetTokenSupplyRange: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	if filter.From.IsZero() {
+		filter.From = smodels.NewTime(time.Now().Add(-time.Hour * 24 * 7))
+	}
+	filter.Token = identifier
+	resp, err := api.svc.GetTokenSupplyRange(filter)
+	if err != nil {
+		log.Error("API GetTokenSupplyRange: svc.GetTokenSupplyRange: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetTokenMints(w http.ResponseWriter, r *http.Request) {
+	identifier, ok := mux.Vars(r)["identifier"]
+	if !ok || identifier == "" {
+		jsonBadRequest(w, "invalid identifier")
+		return
+	}
+	var filter filters.Operations
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetTokenMints: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.Token = identifier
+	filter.TxHash = ""
+	filter.SetMaxLimit(100)
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetTokenMints: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetTokenMints(filter)
+	if err != nil {
+		log.Error("API GetTokenMints: svc.GetTokenMints: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
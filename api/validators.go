@@ -1,12 +1,18 @@
 package api
 
 import (
+	"encoding/json"
 	"github.com/everstake/elrond-monitor-backend/dao/filters"
 	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/smodels"
 	"github.com/gorilla/mux"
+	"github.com/shopspring/decimal"
+	"io"
 	"net/http"
 )
 
+const maxIdentityProfileSize = 64 << 10 // 64 KB
+
 func (api *API) GetStakingProvider(w http.ResponseWriter, r *http.Request) {
 	address, ok := mux.Vars(r)["address"]
 	if !ok || address == "" {
@@ -61,6 +67,16 @@ func (api *API) GetNode(w http.ResponseWriter, r *http.Request) {
 	jsonData(w, provider)
 }
 
+func (api *API) GetQueue(w http.ResponseWriter, r *http.Request) {
+	resp, err := api.svc.GetQueue()
+	if err != nil {
+		log.Error("API GetQueue: svc.GetQueue: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
 func (api *API) GetNodes(w http.ResponseWriter, r *http.Request) {
 	var filter filters.Nodes
 	err := api.queryDecoder.Decode(&filter, r.URL.Query())
@@ -100,6 +116,47 @@ func (api *API) GetValidator(w http.ResponseWriter, r *http.Request) {
 	jsonData(w, provider)
 }
 
+func (api *API) SetIdentityProfile(w http.ResponseWriter, r *http.Request) {
+	identity, ok := mux.Vars(r)["identity"]
+	if !ok || identity == "" {
+		jsonBadRequest(w, "invalid identity")
+		return
+	}
+	var profile smodels.IdentityProfile
+	err := json.NewDecoder(io.LimitReader(r.Body, maxIdentityProfileSize)).Decode(&profile)
+	if err != nil {
+		log.Debug("API SetIdentityProfile: json.Decode: %s", err.Error())
+		jsonBadRequest(w, "bad body")
+		return
+	}
+	err = api.svc.SetIdentityProfile(identity, profile)
+	if err != nil {
+		log.Error("API SetIdentityProfile: svc.SetIdentityProfile: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, map[string]bool{
+		"status": true,
+	})
+}
+
+func (api *API) DeleteIdentityProfile(w http.ResponseWriter, r *http.Request) {
+	identity, ok := mux.Vars(r)["identity"]
+	if !ok || identity == "" {
+		jsonBadRequest(w, "invalid identity")
+		return
+	}
+	err := api.svc.DeleteIdentityProfile(identity)
+	if err != nil {
+		log.Error("API DeleteIdentityProfile: svc.DeleteIdentityProfile: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, map[string]bool{
+		"status": true,
+	})
+}
+
 func (api *API) GetValidators(w http.ResponseWriter, r *http.Request) {
 	var filter filters.Validators
 	err := api.queryDecoder.Decode(&filter, r.URL.Query())
@@ -125,11 +182,96 @@ func (api *API) GetValidators(w http.ResponseWriter, r *http.Request) {
 }
 
 func (api *API) GetRanking(w http.ResponseWriter, r *http.Request) {
-	ranking, err := api.svc.GetRanking()
+	var filter filters.Ranking
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
 	if err != nil {
-		log.Error("API GetRanking: svc.GetRanking: %s", err.Error())
+		log.Debug("API GetRanking: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetRanking: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	if len(filter.Buckets) == 0 {
+		ranking, err := api.svc.GetRanking()
+		if err != nil {
+			log.Error("API GetRanking: svc.GetRanking: %s", err.Error())
+			jsonError(err, w)
+			return
+		}
+		jsonData(w, ranking)
+		return
+	}
+	ranking, err := api.svc.GetCustomRanking(filter)
+	if err != nil {
+		log.Error("API GetRanking: svc.GetCustomRanking: %s", err.Error())
 		jsonError(err, w)
 		return
 	}
 	jsonData(w, ranking)
 }
+
+func (api *API) GetRankingHistory(w http.ResponseWriter, r *http.Request) {
+	var filter filters.RankingHistory
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetRankingHistory: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	resp, err := api.svc.GetRankingHistory(filter)
+	if err != nil {
+		log.Error("API GetRankingHistory: svc.GetRankingHistory: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetProviderDelegators(w http.ResponseWriter, r *http.Request) {
+	address, ok := mux.Vars(r)["address"]
+	if !ok || address == "" {
+		jsonBadRequest(w, "invalid address")
+		return
+	}
+	var filter filters.ProviderDelegators
+	err := api.queryDecoder.Decode(&filter, r.URL.Query())
+	if err != nil {
+		log.Debug("API GetProviderDelegators: Decode: %s", err.Error())
+		jsonBadRequest(w, "bad params")
+		return
+	}
+	filter.Provider = address
+	filter.SetMaxLimit(100)
+	err = filter.Validate()
+	if err != nil {
+		log.Debug("API GetProviderDelegators: filter.Validate: %s", err.Error())
+		jsonBadRequest(w, err.Error())
+		return
+	}
+	resp, err := api.svc.GetProviderDelegators(filter)
+	if err != nil {
+		log.Error("API GetProviderDelegators: svc.GetProviderDelegators: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
+
+func (api *API) GetStakingCalculation(w http.ResponseWriter, r *http.Request) {
+	amount, err := decimal.NewFromString(r.URL.Query().Get("amount"))
+	if err != nil || !amount.IsPositive() {
+		jsonBadRequest(w, "invalid amount")
+		return
+	}
+	resp, err := api.svc.GetStakingCalculation(amount, r.URL.Query().Get("provider"))
+	if err != nil {
+		log.Error("API GetStakingCalculation: svc.GetStakingCalculation: %s", err.Error())
+		jsonError(err, w)
+		return
+	}
+	jsonData(w, resp)
+}
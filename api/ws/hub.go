@@ -67,7 +67,7 @@ func (h *Hub) Run() {
 			}
 		case message := <-h.subscribe:
 			switch message.channel {
-			case BlocksChannel, TransactionsChannel:
+			case BlocksChannel, TransactionsChannel, TPSChannel:
 			default:
 				continue
 			}
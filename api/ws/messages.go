@@ -3,6 +3,7 @@ package ws
 const (
 	BlocksChannel       = "blocks"
 	TransactionsChannel = "transactions"
+	TPSChannel          = "tps"
 
 	SubscribeMsgType   = "subscribe"
 	UnsubscribeMsgType = "unsubscribe"
@@ -9,19 +9,29 @@ import (
 
 const ServiceName = "elrond-monitor-backend"
 
+const (
+	ElasticSearchBackend = "elasticsearch"
+	PostgresBackend      = "postgres"
+)
+
 type (
 	Config struct {
 		API                    API
 		Postgres               Postgres
 		ElasticSearch          ElasticSearch
+		Backend                string // elasticsearch (by default) or postgres
 		MarketProvider         MarketProvider
 		Parser                 Parser
 		Contracts              Contracts
 		StakingProvidersSource string
+		NFTMetadata            NFTMetadata
+		Staking                Staking
+		Identities             Identities
 	}
 	API struct {
 		ListenOnPort       uint16
 		CORSAllowedOrigins []string
+		AdminKey           string // key of the admin routes passed in X-Admin-Key header, admin routes are disabled if empty
 	}
 	Postgres struct {
 		Host     string
@@ -39,6 +49,18 @@ type (
 		Batch    uint64
 		Fetchers uint64
 	}
+	NFTMetadata struct {
+		IPFSGateway   string // e.g. https://ipfs.io/ipfs/
+		ThumbnailsDir string
+		ThumbnailSize uint // max width and height in pixels
+	}
+	Staking struct {
+		UnBondPeriod uint64 // epochs, used for the direct stake and the providers without the period in the source
+	}
+	Identities struct {
+		OverridesFile string // JSON file with the profiles by identities, only the admin overrides have a higher priority than the file
+		CacheTTL      uint64 // hours before the cached profile is resolved again
+	}
 	ElasticSearch struct {
 		Address string
 	}
@@ -78,6 +100,16 @@ func (config *Config) Validate() error {
 	if config.StakingProvidersSource == "" {
 		return fmt.Errorf("StakingProvidersSource is empty")
 	}
+	config.NFTMetadata.setDefaults()
+	config.Staking.setDefaults()
+	config.Identities.setDefaults()
+	switch config.Backend {
+	case "":
+		config.Backend = ElasticSearchBackend
+	case ElasticSearchBackend, PostgresBackend:
+	default:
+		return fmt.Errorf("unknown backend %s", config.Backend)
+	}
 	return config.Postgres.validate()
 }
 
@@ -109,6 +141,30 @@ func (config *Postgres) validate() error {
 	return nil
 }
 
+func (config *NFTMetadata) setDefaults() {
+	if config.IPFSGateway == "" {
+		config.IPFSGateway = "https://ipfs.io/ipfs/"
+	}
+	if config.ThumbnailsDir == "" {
+		config.ThumbnailsDir = "./thumbnails"
+	}
+	if config.ThumbnailSize == 0 {
+		config.ThumbnailSize = 256
+	}
+}
+
+func (config *Staking) setDefaults() {
+	if config.UnBondPeriod == 0 {
+		config.UnBondPeriod = 10
+	}
+}
+
+func (config *Identities) setDefaults() {
+	if config.CacheTTL == 0 {
+		config.CacheTTL = 24
+	}
+}
+
 func (config *Parser) validate() error {
 	if config.Batch == 0 {
 		return fmt.Errorf("batch is zero")
@@ -8,6 +8,8 @@ import (
 	"github.com/everstake/elrond-monitor-backend/dao/filters"
 	"github.com/everstake/elrond-monitor-backend/dao/postgres"
 	"github.com/everstake/elrond-monitor-backend/services/es"
+	"github.com/shopspring/decimal"
+	"time"
 )
 
 type (
@@ -33,10 +35,61 @@ type (
 		GetStakeState() (items []dmodels.StakeState, err error)
 		GetStakeEvents(filter filters.StakeEvents) (items []dmodels.StakeEvent, err error)
 		GetStakeEventsTotal(filter filters.StakeEvents) (total uint64, err error)
+		GetStakeFlows(filter filters.StakeFlows) (items []dmodels.StakeFlow, err error)
+		GetUnbondingEvents(filter filters.UnbondingEvents) (items []dmodels.StakeEvent, err error)
+		GetUnbondingAmounts(fromEpoch uint64) (items []dmodels.UnbondingAmount, err error)
+		GetStakeSources(filter filters.StakeSources) (items []dmodels.SourceStake, err error)
+		GetStakeSourcesHistory(filter filters.StakeSources) (items []dmodels.SourceStake, err error)
+		GetProviderDelegators(filter filters.ProviderDelegators) (items []dmodels.StakeState, err error)
+		GetProviderDelegatorsTotal(filter filters.ProviderDelegators) (total uint64, err error)
+		GetRankingBuckets(boundaries []decimal.Decimal) (items []dmodels.RankingBucket, err error)
+		CreateRankingHistory(items []dmodels.RankingBucket) error
+		GetRankingHistory(filter filters.RankingHistory) (items []dmodels.RankingBucket, err error)
+		GetDelegationsToReconcile(limit uint64) (items []dmodels.DelegationCorrection, err error)
+		UpdateDelegationCorrections(items []dmodels.DelegationCorrection) error
+		GetDelegationDrifts(filter filters.DelegationDrifts) (items []dmodels.DelegationCorrection, err error)
+		GetDelegationDriftsTotal(filter filters.DelegationDrifts) (total uint64, err error)
 
 		// daily stats
 		CreateDailyStats(stats []dmodels.DailyStat) error
 		GetDailyStatsRange(filter filters.DailyStats) (items []dmodels.DailyStat, err error)
+		GetDailyStatsSums(filter filters.DailyStats) (items []dmodels.DailyStat, err error)
+
+		// fees
+		CreateTxFees(fees []dmodels.TxFee) error
+		GetFeeStats(from time.Time, to time.Time) (stats dmodels.FeeStats, err error)
+		GetShardFees(from time.Time, to time.Time) (items []dmodels.ShardFees, err error)
+		GetTopFeeContracts(from time.Time, to time.Time, limit uint64) (items []dmodels.ContractFees, err error)
+		DeleteTxFees(before time.Time) error
+
+		// throughput
+		CreateShardThroughput(items []dmodels.ShardThroughput) error
+		GetThroughputRange(filter filters.Throughput) (items []dmodels.ShardThroughput, err error)
+		GetLatestThroughput(limit uint64) (items []dmodels.ShardThroughput, err error)
+		GetPeakThroughput(filter filters.Throughput) (item dmodels.ShardThroughput, err error)
+		GetShardsThroughput(filter filters.Throughput) (items []dmodels.ShardThroughput, err error)
+
+		// validators
+		UpdateValidatorEpochs(items []dmodels.ValidatorEpoch) error
+		CompleteValidatorEpochs(items []dmodels.ValidatorEpoch) error
+		GetValidatorEpochs(filter filters.ValidatorEpochs) (items []dmodels.ValidatorEpoch, err error)
+		UpdateQueueSnapshots(items []dmodels.QueueSnapshot) error
+		GetQueueMovement(epochs uint64) (items []dmodels.QueueMovement, err error)
+		GetQueuePositions(key string) (items []dmodels.QueueSnapshot, err error)
+		GetIdentityProfile(identity string) (profile dmodels.IdentityProfile, err error)
+		UpdateIdentityProfile(profile dmodels.IdentityProfile) error
+		DeleteIdentityProfileOverride(identity string) error
+
+		// epochs
+		CreateEpochs(epochs []dmodels.Epoch) error
+		CreateEpochSnapshot(snapshot dmodels.EpochSnapshot) error
+		GetEpochs(filter filters.Epochs) (epochs []dmodels.EpochSummary, err error)
+		GetEpochsTotal(filter filters.Epochs) (total uint64, err error)
+		GetEpochSummary(epoch uint64) (summary dmodels.EpochSummary, err error)
+
+		// providers
+		UpdateProviderRewards(items []dmodels.ProviderRewards) error
+		GetProviderEpochRewards(from time.Time) (items []dmodels.ProviderEpochRewards, err error)
 
 		// tokens
 		CreateToken(token dmodels.Token) error
@@ -48,9 +101,51 @@ type (
 		// nft collections
 		CreateNFTCollection(collection dmodels.NFTCollection) error
 		UpdateNFTCollection(collection dmodels.NFTCollection) error
+		UpdateNFTCollectionStats(identity string, stats dmodels.NFTCollectionStats) error
+		MarkTokensChanged(identifiers []string, changedAt time.Time) error
+		MarkTokensIssued(items []dmodels.TokenSync) error
+		UpdateTokenSynced(identifier string, syncedAt time.Time) error
+		GetTokenSync(identifier string) (item dmodels.TokenSync, err error)
+		GetTokensSync() (items []dmodels.TokenSync, err error)
 		GetNFTCollections(filter filters.NFTCollections) (collections []dmodels.NFTCollection, err error)
 		GetNFTCollectionsTotal(filter filters.NFTCollections) (total uint64, err error)
 		GetNFTCollection(ident string) (collection dmodels.NFTCollection, err error)
+		CreateNFTMetadata(items []dmodels.NFTMetadata) error
+		UpdateNFTMetadata(item dmodels.NFTMetadata) error
+		GetNFTMetadata(identifier string) (item dmodels.NFTMetadata, err error)
+		GetNFTMetadataQueue(limit uint64) (items []dmodels.NFTMetadata, err error)
+
+		// index (postgres backend)
+		CreateBlocks(blocks []dmodels.Block) error
+		CreateMiniBlocks(miniblocks []dmodels.MiniBlock) error
+		CreateTransactions(transactions []dmodels.Transaction) error
+		CreateSCResults(results []dmodels.SCResult) error
+		CreateOperations(operations []dmodels.Operation) error
+		UpdateAccounts(accounts []dmodels.Account) error
+		UpdateESDTAccounts(accounts []dmodels.ESDTAccount) error
+
+		// balance changes
+		CreateBalanceChanges(changes []dmodels.BalanceChange) error
+		GetBalanceChangesSums(filter filters.BalanceHistory) (items []dmodels.BalanceChangesSum, err error)
+		GetBalanceChangesTotal(filter filters.BalanceHistory) (total decimal.Decimal, err error)
+		CreateTokenTransfers(transfers []dmodels.TokenTransfer) error
+		GetTokenTransfersSums(from time.Time, to time.Time) (items []dmodels.TokenTransfersSum, err error)
+		CreateTokenDailyStats(stats []dmodels.TokenDailyStat) error
+		GetTokenDailyStatsRange(filter filters.TokenDailyStats) (items []dmodels.TokenDailyStat, err error)
+		UpdateTokenSupply(supply dmodels.TokenSupply) error
+		GetTokenSupplyRange(filter filters.TokenDailyStats) (items []dmodels.TokenSupply, err error)
+		CreateContracts(contracts []dmodels.Contract) error
+		CreateContractPlaceholders(addresses []string) error
+		UpgradeContract(contract dmodels.Contract) error
+		UpdateContractOwner(contract dmodels.Contract) error
+		CreateContractCalls(calls []dmodels.ContractCall) error
+		GetContracts(filter filters.Contracts) (contracts []dmodels.ContractActivity, err error)
+		GetContractsTotal(filter filters.Contracts) (total uint64, err error)
+		GetContract(address string) (contract dmodels.ContractActivity, err error)
+		GetContractCalls(filter filters.ContractCalls) (calls []dmodels.ContractCall, err error)
+		GetContractCallsTotal(filter filters.ContractCalls) (total uint64, err error)
+		UpdateContractABI(contractABI dmodels.ContractABI) error
+		GetContractABIs() (items []dmodels.ContractABI, err error)
 	}
 
 	ElasticSearch interface {
@@ -66,10 +161,15 @@ type (
 		GetAccount(address string) (acc data.AccountInfo, err error)
 		GetAccounts(filter filters.Accounts) (accounts []data.AccountInfo, err error)
 		GetAccountsCount(filter filters.Accounts) (total uint64, err error)
+		GetAccountsDistribution(buckets []es.BalanceBucket) ([]es.BalanceBucket, error)
 		GetESDTAccounts(filter filters.ESDT) (accounts []es.AccountESDT, err error)
 		GetESDTAccountsCount(filter filters.ESDT) (total uint64, err error)
+		GetESDTHoldersCount(filter filters.ESDT) (total uint64, err error)
+		GetESDTCollections(filter filters.ESDT) (collections []string, err error)
+		GetESDTCollectionsCount(filter filters.ESDT) (total uint64, err error)
 		GetOperations(filter filters.Operations) (txs []es.Operation, err error)
 		GetOperationsCount(filter filters.Operations) (total uint64, err error)
+		GetOperationsDailyCounts(filter filters.Operations) (items []es.OperationsCount, err error)
 		GetTokenInfo(id string) (token data.TokenInfo, err error)
 		GetNFTTokens(filter filters.NFTTokens) (txs []data.TokenInfo, err error)
 		GetNFTTokensCount(filter filters.NFTTokens) (total uint64, err error)
@@ -86,11 +186,23 @@ type (
 	}
 )
 
+var (
+	_ ElasticSearch = (*es.Client)(nil)
+	_ ElasticSearch = (*es.Memory)(nil)
+	_ ElasticSearch = (*postgres.Postgres)(nil)
+)
+
 func NewDAO(cfg config.Config) (DAO, error) {
 	postgresDB, err := postgres.NewPostgres(cfg.Postgres)
 	if err != nil {
 		return nil, fmt.Errorf("postgres.NewPostgres: %s", err.Error())
 	}
+	if cfg.Backend == config.PostgresBackend {
+		return daoImpl{
+			Postgres:      postgresDB,
+			ElasticSearch: postgresDB,
+		}, nil
+	}
 	elastic, err := es.NewClient(cfg.ElasticSearch.Address)
 	if err != nil {
 		return nil, fmt.Errorf("es.NewClient: %s", err.Error())
@@ -4,4 +4,6 @@ import "errors"
 
 var (
 	NotFound = errors.New("not found")
+	// NotSupported is returned when the data isn't available in the chosen backend
+	NotSupported = errors.New("not supported")
 )
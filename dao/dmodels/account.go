@@ -1,12 +1,27 @@
 package dmodels
 
 import (
+	"github.com/shopspring/decimal"
 	"time"
 )
 
-const AccountsTable = "accounts"
+const (
+	AccountsTable     = "accounts"
+	ESDTAccountsTable = "esdt_accounts"
+)
 
 type Account struct {
-	Address   string    `db:"acc_address"`
-	CreatedAt time.Time `db:"acc_created_at"`
+	Address   string          `db:"acc_address"`
+	Balance   decimal.Decimal `db:"acc_balance"`
+	Nonce     uint64          `db:"acc_nonce"`
+	CreatedAt time.Time       `db:"acc_created_at"`
+	UpdatedAt time.Time       `db:"acc_updated_at"`
+}
+
+type ESDTAccount struct {
+	Address    string          `db:"esa_address"`
+	Token      string          `db:"esa_token"`
+	TokenNonce uint64          `db:"esa_token_nonce"`
+	Balance    decimal.Decimal `db:"esa_balance"`
+	UpdatedAt  time.Time       `db:"esa_updated_at"`
 }
@@ -0,0 +1,26 @@
+package dmodels
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const (
+	BalanceChangesTable = "balance_changes"
+
+	EGLDToken = "EGLD"
+)
+
+// BalanceChange is a sum of balance deltas of the address in the single hyperblock
+type BalanceChange struct {
+	Address   string          `db:"bch_address"`
+	Token     string          `db:"bch_token"`
+	Block     uint64          `db:"bch_block"`
+	Amount    decimal.Decimal `db:"bch_amount"`
+	CreatedAt time.Time       `db:"bch_created_at"`
+}
+
+type BalanceChangesSum struct {
+	Time   time.Time       `db:"time"`
+	Amount decimal.Decimal `db:"amount"`
+}
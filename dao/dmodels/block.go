@@ -1,6 +1,7 @@
 package dmodels
 
 import (
+	"github.com/lib/pq"
 	"github.com/shopspring/decimal"
 	"time"
 )
@@ -20,6 +21,7 @@ type (
 		Epoch           uint64          `db:"blk_epoch" json:"epoch"`
 		Status          string          `db:"blk_status" json:"status"`
 		PrevBlockHash   string          `db:"blk_prev_block_hash" json:"prev_block_hash"`
+		MiniBlocks      pq.StringArray  `db:"blk_miniblocks" json:"miniblocks"`
 		AccumulatedFees decimal.Decimal `db:"blk_accumulated_fees" json:"accumulated_fees"`
 		DeveloperFees   decimal.Decimal `db:"blk_developer_fees" json:"developer_fees"`
 		CreatedAt       time.Time       `db:"blk_created_at" json:"created_at"`
@@ -0,0 +1,42 @@
+package dmodels
+
+import "time"
+
+const (
+	ContractsTable     = "contracts"
+	ContractCallsTable = "contract_calls"
+	ContractABIsTable  = "contract_abis"
+)
+
+type (
+	// Contract is a deployed smart contract, the contracts deployed before the parsed range have empty deployer and deploy tx
+	Contract struct {
+		Address       string    `db:"ctr_address"`
+		Deployer      string    `db:"ctr_deployer"`
+		Owner         string    `db:"ctr_owner"`
+		CodeHash      string    `db:"ctr_code_hash"`
+		DeployTxHash  string    `db:"ctr_deploy_tx_hash"`
+		UpgradeTxHash string    `db:"ctr_upgrade_tx_hash"`
+		Upgrades      uint64    `db:"ctr_upgrades"`
+		DeployedAt    time.Time `db:"ctr_deployed_at"`
+		UpdatedAt     time.Time `db:"ctr_updated_at"`
+	}
+	// ContractCall is a number of calls of the contract function, Block is the last applied hyperblock
+	ContractCall struct {
+		Contract   string    `db:"ccl_contract"`
+		Function   string    `db:"ccl_function"`
+		Calls      uint64    `db:"ccl_calls"`
+		Block      uint64    `db:"ccl_block"`
+		LastCallAt time.Time `db:"ccl_last_call_at"`
+	}
+	ContractABI struct {
+		Address   string    `db:"cab_address"`
+		ABI       []byte    `db:"cab_abi"`
+		CreatedAt time.Time `db:"cab_created_at"`
+	}
+	ContractActivity struct {
+		Contract
+		Calls      uint64    `db:"calls"`
+		LastCallAt time.Time `db:"last_call_at"`
+	}
+)
@@ -0,0 +1,19 @@
+package dmodels
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const DelegationCorrectionsTable = "delegation_corrections"
+
+// DelegationCorrection is the difference between the active stake of the delegator taken from the provider contract
+// and the stake parsed from the stake events, the drift is added to the parsed stake
+type DelegationCorrection struct {
+	Provider  string          `db:"dlc_provider"`
+	Delegator string          `db:"dlc_delegator"`
+	Parsed    decimal.Decimal `db:"dlc_parsed"`
+	OnChain   decimal.Decimal `db:"dlc_on_chain"`
+	Drift     decimal.Decimal `db:"dlc_drift"`
+	CheckedAt time.Time       `db:"dlc_checked_at"`
+}
@@ -0,0 +1,41 @@
+package dmodels
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const (
+	EpochsTable         = "epochs"
+	EpochSnapshotsTable = "epoch_snapshots"
+)
+
+type (
+	// Epoch is a sum of the parsed hyperblocks of the epoch, EndNonce is the last applied hyperblock
+	Epoch struct {
+		Epoch      uint64          `db:"epc_epoch"`
+		StartNonce uint64          `db:"epc_start_nonce"`
+		EndNonce   uint64          `db:"epc_end_nonce"`
+		StartTime  time.Time       `db:"epc_start_time"`
+		EndTime    time.Time       `db:"epc_end_time"`
+		Txs        uint64          `db:"epc_txs"`
+		Fees       decimal.Decimal `db:"epc_fees"`
+		Rewards    decimal.Decimal `db:"epc_rewards"`
+	}
+	// EpochSnapshot is the state of the network taken once the epoch has started
+	EpochSnapshot struct {
+		Epoch      uint64          `db:"eps_epoch"`
+		Validators uint64          `db:"eps_validators"`
+		Stake      decimal.Decimal `db:"eps_stake"`
+		TopUp      decimal.Decimal `db:"eps_top_up"`
+		Shards     uint64          `db:"eps_shards"`
+		CreatedAt  time.Time       `db:"eps_created_at"`
+	}
+	EpochSummary struct {
+		Epoch
+		Validators uint64          `db:"eps_validators"`
+		Stake      decimal.Decimal `db:"eps_stake"`
+		TopUp      decimal.Decimal `db:"eps_top_up"`
+		Shards     uint64          `db:"eps_shards"`
+	}
+)
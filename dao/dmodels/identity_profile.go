@@ -0,0 +1,20 @@
+package dmodels
+
+import "time"
+
+const IdentityProfilesTable = "identity_profiles"
+
+// IdentityProfileOverrideSource is the source of the profiles set by the admin
+const IdentityProfileOverrideSource = "override"
+
+// IdentityProfile is the cached profile of the validator identity, the overridden profile isn't replaced by the cache
+type IdentityProfile struct {
+	Identity    string    `db:"idp_identity"`
+	Name        string    `db:"idp_name"`
+	Avatar      string    `db:"idp_avatar"`
+	Description string    `db:"idp_description"`
+	Website     string    `db:"idp_website"`
+	Source      string    `db:"idp_source"`
+	Override    bool      `db:"idp_override"`
+	UpdatedAt   time.Time `db:"idp_updated_at"`
+}
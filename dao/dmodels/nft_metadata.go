@@ -0,0 +1,28 @@
+package dmodels
+
+import "time"
+
+const NFTMetadataTable = "nft_metadata"
+
+const (
+	NFTMetadataPending    = "pending"
+	NFTMetadataResolved   = "resolved"
+	NFTMetadataNoMetadata = "no_metadata"
+	NFTMetadataFailed     = "failed" // retried later
+	NFTMetadataAbandoned  = "abandoned"
+)
+
+type NFTMetadata struct {
+	Identifier    string    `db:"nmt_identifier"`
+	Status        string    `db:"nmt_status"`
+	Attributes    []byte    `db:"nmt_attributes"`
+	Metadata      []byte    `db:"nmt_metadata"`
+	MetadataURL   string    `db:"nmt_metadata_url"`
+	ImageURL      string    `db:"nmt_image_url"`
+	Thumbnail     string    `db:"nmt_thumbnail"`
+	Error         string    `db:"nmt_error"`
+	Attempts      uint64    `db:"nmt_attempts"`
+	NextAttemptAt time.Time `db:"nmt_next_attempt_at"`
+	CreatedAt     time.Time `db:"nmt_created_at"`
+	UpdatedAt     time.Time `db:"nmt_updated_at"`
+}
@@ -0,0 +1,42 @@
+package dmodels
+
+import (
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	OperationsTable = "operations"
+
+	ESDTTransferOperation         = "ESDTTransfer"
+	ESDTNFTTransferOperation      = "ESDTNFTTransfer"
+	MultiESDTNFTTransferOperation = "MultiESDTNFTTransfer"
+	ESDTLocalMintOperation        = "ESDTLocalMint"
+	ESDTLocalBurnOperation        = "ESDTLocalBurn"
+	ESDTNFTCreateOperation        = "ESDTNFTCreate"
+	ESDTNFTBurnOperation          = "ESDTNFTBurn"
+	ESDTNFTAddQuantityOperation   = "ESDTNFTAddQuantity"
+)
+
+type Operation struct {
+	Hash           string         `db:"opr_hash"`
+	OriginalTxHash string         `db:"opr_original_tx_hash"`
+	Nonce          uint64         `db:"opr_nonce"`
+	Sender         string         `db:"opr_sender"`
+	Receiver       string         `db:"opr_receiver"`
+	SenderShard    uint64         `db:"opr_sender_shard"`
+	ReceiverShard  uint64         `db:"opr_receiver_shard"`
+	Operation      string         `db:"opr_operation"`
+	Status         string         `db:"opr_status"`
+	Tokens         pq.StringArray `db:"opr_tokens"`
+	ESDTValues     pq.StringArray `db:"opr_esdt_values"`
+	Data           string         `db:"opr_data"`
+	CreatedAt      time.Time      `db:"opr_created_at"`
+}
+
+// OperationsCount counts the operations of the type within the day
+type OperationsCount struct {
+	Day       time.Time `db:"day"`
+	Operation string    `db:"opr_operation"`
+	Count     uint64    `db:"count"`
+}
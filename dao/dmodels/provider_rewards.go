@@ -0,0 +1,26 @@
+package dmodels
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const ProviderRewardsTable = "provider_rewards"
+
+// ProviderRewards is the last snapshot of the delegation contract within the epoch
+type ProviderRewards struct {
+	Provider         string          `db:"prw_provider"`
+	Epoch            uint64          `db:"prw_epoch"`
+	CumulatedRewards decimal.Decimal `db:"prw_cumulated_rewards"`
+	ActiveStake      decimal.Decimal `db:"prw_active_stake"`
+	UpdatedAt        time.Time       `db:"prw_updated_at"`
+}
+
+// ProviderEpochRewards is the rewards distributed to the delegators since the previous snapshot
+type ProviderEpochRewards struct {
+	Provider    string          `db:"provider"`
+	Epoch       uint64          `db:"epoch"`
+	Epochs      uint64          `db:"epochs"`
+	Rewards     decimal.Decimal `db:"rewards"`
+	ActiveStake decimal.Decimal `db:"active_stake"`
+}
@@ -0,0 +1,19 @@
+package dmodels
+
+import "time"
+
+const QueueSnapshotsTable = "queue_snapshots"
+
+// QueueSnapshot is the last position of the BLS key in the queue within the epoch
+type QueueSnapshot struct {
+	Epoch     uint64    `db:"qsn_epoch"`
+	Key       string    `db:"qsn_key"`
+	Position  int64     `db:"qsn_position"`
+	UpdatedAt time.Time `db:"qsn_updated_at"`
+}
+
+// QueueMovement is the number of the keys which have left the queue by the next epoch
+type QueueMovement struct {
+	Epoch uint64 `db:"epoch"`
+	Left  uint64 `db:"left_nodes"`
+}
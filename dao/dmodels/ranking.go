@@ -0,0 +1,17 @@
+package dmodels
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const RankingHistoryTable = "ranking_history"
+
+// RankingBucket is the delegators of the provider with the stake from the lower boundary to the next one
+type RankingBucket struct {
+	Day      time.Time       `db:"rkh_day"`
+	Provider string          `db:"rkh_provider"`
+	From     decimal.Decimal `db:"rkh_from"`
+	Count    uint64          `db:"rkh_count"`
+	Amount   decimal.Decimal `db:"rkh_amount"`
+}
@@ -2,16 +2,18 @@ package dmodels
 
 import (
 	"github.com/shopspring/decimal"
+	"time"
 )
 
 const SCResultsTable = "sc_results"
 
 type SCResult struct {
-	Hash    string          `db:"scr_hash"`
-	TxHash  string          `db:"trn_hash"`
-	From    string          `db:"scr_from"`
-	To      string          `db:"scr_to"`
-	Value   decimal.Decimal `db:"scr_value"`
-	Data    string          `db:"scr_data"`
-	Message string          `db:"scr_message"`
+	Hash      string          `db:"scr_hash"`
+	TxHash    string          `db:"trn_hash"`
+	From      string          `db:"scr_from"`
+	To        string          `db:"scr_to"`
+	Value     decimal.Decimal `db:"scr_value"`
+	Data      string          `db:"scr_data"`
+	Message   string          `db:"scr_message"`
+	CreatedAt time.Time       `db:"scr_created_at"`
 }
@@ -19,6 +19,12 @@ const (
 	UnBondEventType            = "unBond"
 )
 
+const (
+	DirectStakeSource    = "direct"
+	LegacyStakeSource    = "legacy"
+	ProvidersStakeSource = "providers"
+)
+
 type StakeEvent struct {
 	TxHash    string          `db:"ste_tx_hash"`
 	Type      string          `db:"ste_type"`
@@ -34,3 +40,28 @@ type StakeState struct {
 	Delegator string          `db:"delegator"`
 	Amount    decimal.Decimal `db:"amount"`
 }
+
+// StakeFlow is the daily movement of the delegated stake, the outflow is positive
+type StakeFlow struct {
+	Day               time.Time       `db:"day"`
+	Inflow            decimal.Decimal `db:"inflow"`
+	Outflow           decimal.Decimal `db:"outflow"`
+	Redelegated       decimal.Decimal `db:"redelegated"`
+	Withdrawn         decimal.Decimal `db:"withdrawn"`
+	NewDelegators     uint64          `db:"new_delegators"`
+	LeavingDelegators uint64          `db:"leaving_delegators"`
+}
+
+// UnbondingAmount is the undelegated and unstaked amount of the validator within the epoch
+type UnbondingAmount struct {
+	Validator string          `db:"validator"`
+	Epoch     uint64          `db:"epoch"`
+	Amount    decimal.Decimal `db:"amount"`
+}
+
+// SourceStake is the stake of the source at the end of the day
+type SourceStake struct {
+	Day    time.Time       `db:"day"`
+	Source string          `db:"source"`
+	Amount decimal.Decimal `db:"amount"`
+}
@@ -10,6 +10,8 @@ const (
 	ValidatorStatsStorageKey   = "validator_stats"
 	ValidatorsMapStorageKey    = "validators_map"
 	RankingStorageKey          = "ranking"
+	DistributionStorageKey     = "distribution"
+	TokensSyncStorageKey       = "tokens_sync"
 )
 
 type StorageItem struct {
@@ -0,0 +1,17 @@
+package dmodels
+
+import "time"
+
+const ShardThroughputTable = "shard_throughput"
+
+// ShardThroughput is a sum of the shard blocks within the minute, Block is the last applied hyperblock.
+// Txs are the transactions sent from the shard, Size is the number of all transactions processed in the blocks
+type ShardThroughput struct {
+	Shard       uint64    `db:"stp_shard"`
+	Blocks      uint64    `db:"stp_blocks"`
+	EmptyBlocks uint64    `db:"stp_empty_blocks"`
+	Txs         uint64    `db:"stp_txs"`
+	Size        uint64    `db:"stp_size"`
+	Block       uint64    `db:"stp_block"`
+	CreatedAt   time.Time `db:"stp_created_at"`
+}
@@ -36,4 +36,15 @@ type NFTCollection struct {
 	Type       string    `db:"nfc_type"`
 	Properties []byte    `db:"nfc_properties"`
 	CreatedAt  time.Time `db:"nfc_created_at"`
+	NFTCollectionStats
+}
+
+// NFTCollectionStats is computed periodically, mints and transfers are counted for the last 24 hours
+type NFTCollectionStats struct {
+	Items           uint64    `db:"nfc_items"`
+	Holders         uint64    `db:"nfc_holders"`
+	MintsPerDay     uint64    `db:"nfc_mints_per_day"`
+	TransfersPerDay uint64    `db:"nfc_transfers_per_day"`
+	Creators        []byte    `db:"nfc_creators"`
+	StatsUpdatedAt  time.Time `db:"nfc_stats_updated_at"`
 }
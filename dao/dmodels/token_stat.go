@@ -0,0 +1,34 @@
+package dmodels
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const (
+	TokenTransfersTable  = "token_transfers"
+	TokenDailyStatsTable = "token_daily_stats"
+)
+
+type (
+	// TokenTransfer is a sum of transfers of the token (or NFT collection) in the single hyperblock
+	TokenTransfer struct {
+		Token     string          `db:"ttr_token"`
+		Block     uint64          `db:"ttr_block"`
+		Count     uint64          `db:"ttr_count"`
+		Volume    decimal.Decimal `db:"ttr_volume"`
+		CreatedAt time.Time       `db:"ttr_created_at"`
+	}
+	TokenTransfersSum struct {
+		Token  string          `db:"token"`
+		Count  uint64          `db:"count"`
+		Volume decimal.Decimal `db:"volume"`
+	}
+	TokenDailyStat struct {
+		Token     string          `db:"tds_token"`
+		Holders   uint64          `db:"tds_holders"`
+		Transfers uint64          `db:"tds_transfers"`
+		Volume    decimal.Decimal `db:"tds_volume"`
+		CreatedAt time.Time       `db:"tds_created_at"`
+	}
+)
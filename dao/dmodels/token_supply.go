@@ -0,0 +1,15 @@
+package dmodels
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const TokenSupplyTable = "token_supply"
+
+// TokenSupply is the last known supply of the token within the day
+type TokenSupply struct {
+	Token     string          `db:"tsp_token"`
+	Supply    decimal.Decimal `db:"tsp_supply"`
+	CreatedAt time.Time       `db:"tsp_created_at"`
+}
@@ -0,0 +1,14 @@
+package dmodels
+
+import "time"
+
+const TokensSyncTable = "tokens_sync"
+
+// TokenSync tracks when the token (or NFT collection) was refreshed and when a change of it was seen in the chain,
+// CreatedAt is the time of the issue tx, it's null for the tokens issued before the parsing
+type TokenSync struct {
+	Identifier string     `db:"tks_identifier"`
+	ChangedAt  time.Time  `db:"tks_changed_at"`
+	SyncedAt   time.Time  `db:"tks_synced_at"`
+	CreatedAt  *time.Time `db:"tks_created_at"`
+}
@@ -24,7 +24,12 @@ type Transaction struct {
 	Receiver      string          `db:"trn_receiver"`
 	ReceiverShard uint64          `db:"trn_receiver_shard"`
 	GasPrice      uint64          `db:"trn_gas_price"`
+	GasLimit      uint64          `db:"trn_gas_limit"`
+	GasUsed       uint64          `db:"trn_gas_used"`
+	Fee           decimal.Decimal `db:"trn_fee"`
 	Nonce         uint64          `db:"trn_nonce"`
+	Round         uint64          `db:"trn_round"`
+	Signature     string          `db:"trn_signature"`
 	Data          string          `db:"trn_data"`
 	CreatedAt     time.Time       `db:"trn_created_at"`
 }
@@ -0,0 +1,39 @@
+package dmodels
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const TxFeesTable = "tx_fees"
+
+type (
+	// TxFee is the fee paid by the sender of the transaction, the table keeps only the last days
+	TxFee struct {
+		Hash      string          `db:"txf_hash"`
+		Shard     uint64          `db:"txf_shard"`
+		Contract  string          `db:"txf_contract"`
+		Fee       decimal.Decimal `db:"txf_fee"`
+		GasUsed   uint64          `db:"txf_gas_used"`
+		GasLimit  uint64          `db:"txf_gas_limit"`
+		CreatedAt time.Time       `db:"txf_created_at"`
+	}
+	FeeStats struct {
+		Total    decimal.Decimal `db:"total"`
+		Count    uint64          `db:"count"`
+		Median   decimal.Decimal `db:"median"`
+		P90      decimal.Decimal `db:"p90"`
+		GasUsed  decimal.Decimal `db:"gas_used"`
+		GasLimit decimal.Decimal `db:"gas_limit"`
+	}
+	ShardFees struct {
+		Shard uint64          `db:"txf_shard"`
+		Count uint64          `db:"count"`
+		Total decimal.Decimal `db:"total"`
+	}
+	ContractFees struct {
+		Contract string          `db:"txf_contract"`
+		Count    uint64          `db:"count"`
+		Total    decimal.Decimal `db:"total"`
+	}
+)
@@ -0,0 +1,22 @@
+package dmodels
+
+import "time"
+
+const ValidatorEpochsTable = "validator_epochs"
+
+// ValidatorEpoch is the consensus activity of the BLS key within the epoch, the counters are taken from the validator statistics.
+// The totals are the counters of all the previous epochs, the node adds the epoch to them when it resets the counters
+type ValidatorEpoch struct {
+	Key                   string    `db:"vep_key"`
+	Epoch                 uint64    `db:"vep_epoch"`
+	Shard                 uint64    `db:"vep_shard"`
+	Proposed              uint64    `db:"vep_proposed"`
+	Missed                uint64    `db:"vep_missed"`
+	Signed                uint64    `db:"vep_signed"`
+	MissedSignatures      uint64    `db:"vep_missed_signatures"`
+	TotalProposed         uint64    `db:"vep_total_proposed"`
+	TotalMissed           uint64    `db:"vep_total_missed"`
+	TotalSigned           uint64    `db:"vep_total_signed"`
+	TotalMissedSignatures uint64    `db:"vep_total_missed_signatures"`
+	UpdatedAt             time.Time `db:"vep_updated_at"`
+}
@@ -1,5 +1,84 @@
 package filters
 
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"time"
+)
+
+const (
+	AccountsSortByBalanceDesc = "balance_desc"
+	AccountsSortByBalanceAsc  = "balance_asc"
+
+	HourInterval  = "hour"
+	DayInterval   = "day"
+	MonthInterval = "month"
+
+	maxHistoryPoints = 1000
+)
+
 type Accounts struct {
 	Pagination
+	Sort string `schema:"sort"`
+}
+
+func (f *Accounts) Validate() error {
+	switch f.Sort {
+	case "":
+		f.Sort = AccountsSortByBalanceDesc
+	case AccountsSortByBalanceDesc, AccountsSortByBalanceAsc:
+	default:
+		return fmt.Errorf("unknown sort %s", f.Sort)
+	}
+	return f.Pagination.Validate()
+}
+
+// SortOrder returns asc or desc order of balance sorting
+func (f Accounts) SortOrder() string {
+	if f.Sort == AccountsSortByBalanceAsc {
+		return "asc"
+	}
+	return "desc"
+}
+
+type BalanceHistory struct {
+	Address  string       `schema:"-"`
+	Token    string       `schema:"token"`
+	From     smodels.Time `schema:"from"`
+	To       smodels.Time `schema:"to"`
+	Interval string       `schema:"interval"`
+}
+
+func (f *BalanceHistory) Validate() error {
+	if f.Token == "" {
+		f.Token = dmodels.EGLDToken
+	}
+	if f.Interval == "" {
+		f.Interval = DayInterval
+	}
+	if f.To.IsZero() {
+		f.To = smodels.NewTime(time.Now())
+	}
+	if f.From.IsZero() {
+		f.From = smodels.NewTime(f.To.AddDate(0, -1, 0))
+	}
+	if !f.From.Before(f.To.Time) {
+		return fmt.Errorf("from should be less than to")
+	}
+	var step time.Duration
+	switch f.Interval {
+	case HourInterval:
+		step = time.Hour
+	case DayInterval:
+		step = time.Hour * 24
+	case MonthInterval:
+		step = time.Hour * 24 * 28
+	default:
+		return fmt.Errorf("unknown interval %s", f.Interval)
+	}
+	if f.To.Sub(f.From.Time)/step > maxHistoryPoints {
+		return fmt.Errorf("too many points, increase interval")
+	}
+	return nil
 }
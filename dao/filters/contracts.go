@@ -0,0 +1,12 @@
+package filters
+
+type Contracts struct {
+	Deployer string `schema:"deployer"`
+	Owner    string `schema:"owner"`
+	Pagination
+}
+
+type ContractCalls struct {
+	Contract string `schema:"-"`
+	Pagination
+}
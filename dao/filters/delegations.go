@@ -0,0 +1,6 @@
+package filters
+
+type DelegationDrifts struct {
+	Provider string `schema:"provider"`
+	Pagination
+}
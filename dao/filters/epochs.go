@@ -0,0 +1,5 @@
+package filters
+
+type Epochs struct {
+	Pagination
+}
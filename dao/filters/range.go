@@ -8,3 +8,17 @@ type DailyStats struct {
 	From  smodels.Time `schema:"from"`
 	To    smodels.Time `schema:"to"`
 }
+
+type TokenDailyStats struct {
+	Token string       `schema:"-"`
+	Key   string       `schema:"-"`
+	Limit uint64       `schema:"limit"`
+	From  smodels.Time `schema:"from"`
+	To    smodels.Time `schema:"to"`
+}
+
+type Throughput struct {
+	Shard []uint64     `schema:"shard"`
+	From  smodels.Time `schema:"from"`
+	To    smodels.Time `schema:"to"`
+}
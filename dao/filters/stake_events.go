@@ -1,7 +1,37 @@
 package filters
 
+import "github.com/everstake/elrond-monitor-backend/smodels"
+
 type StakeEvents struct {
 	Validator []string `schema:"validator"`
 	Delegator []string `schema:"delegator"`
 	Pagination
 }
+
+type StakeFlows struct {
+	Provider string       `schema:"-"`
+	From     smodels.Time `schema:"from"`
+	To       smodels.Time `schema:"to"`
+}
+
+type UnbondingEvents struct {
+	Delegator string
+	// FromEpoch excludes the events of the previous epochs
+	FromEpoch uint64
+}
+
+type StakeSources struct {
+	Delegator string
+	// Legacy is the address of the legacy delegation contract
+	Legacy string
+	// Direct are the addresses of the staking and auction contracts, the stake sent to other contracts is skipped
+	Direct []string
+	// To excludes the days after the time, the history starts from the first event
+	To smodels.Time
+}
+
+type StakeSourcesRange struct {
+	Address string       `schema:"-"`
+	From    smodels.Time `schema:"from"`
+	To      smodels.Time `schema:"to"`
+}
@@ -1,5 +1,19 @@
 package filters
 
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"time"
+)
+
+const (
+	NFTCollectionsSortByCreatedAt = "created_at"
+	NFTCollectionsSortByItems     = "items"
+	NFTCollectionsSortByHolders   = "holders"
+	NFTCollectionsSortByMints     = "mints"
+	NFTCollectionsSortByTransfers = "transfers"
+)
+
 type Tokens struct {
 	Identifier []string `schema:"identifier"`
 	Pagination
@@ -11,11 +25,50 @@ type NFTTokens struct {
 }
 
 type NFTCollections struct {
+	Sort string `schema:"sort"`
 	Pagination
 }
 
+func (f *NFTCollections) Validate() error {
+	switch f.Sort {
+	case "":
+		f.Sort = NFTCollectionsSortByCreatedAt
+	case NFTCollectionsSortByCreatedAt, NFTCollectionsSortByItems, NFTCollectionsSortByHolders,
+		NFTCollectionsSortByMints, NFTCollectionsSortByTransfers:
+	default:
+		return fmt.Errorf("unknown sort %s", f.Sort)
+	}
+	return f.Pagination.Validate()
+}
+
+// NFTCollectionStats selects the days of the mints and transfers history of the collection
+type NFTCollectionStats struct {
+	Identifier string       `schema:"-"`
+	From       smodels.Time `schema:"from"`
+	To         smodels.Time `schema:"to"`
+}
+
+func (f *NFTCollectionStats) Validate() error {
+	if f.To.IsZero() {
+		f.To = smodels.NewTime(time.Now())
+	}
+	if f.From.IsZero() {
+		f.From = smodels.NewTime(f.To.AddDate(0, -1, 0))
+	}
+	if !f.From.Before(f.To.Time) {
+		return fmt.Errorf("from should be less than to")
+	}
+	if f.To.Sub(f.From.Time)/(time.Hour*24) > maxHistoryPoints {
+		return fmt.Errorf("too many days")
+	}
+	return nil
+}
+
 type ESDT struct {
 	TokenIdentifier string `schema:"token_identifier"`
 	Address         string `schema:"address"`
+	TokenNonce      uint64 `schema:"-"`
+	// NFT selects only NFT/SFT balances (the ones with non-zero nonce) ordered by collection and nonce
+	NFT bool `schema:"-"`
 	Pagination
 }
@@ -1,5 +1,7 @@
 package filters
 
+import "github.com/everstake/elrond-monitor-backend/smodels"
+
 type Transactions struct {
 	Pagination
 	Address   string `schema:"address"`
@@ -7,8 +9,10 @@ type Transactions struct {
 }
 
 type Operations struct {
-	Token  string   `schema:"token"`
-	TxHash string   `schema:"tx_hash"`
-	Type   []string `schema:"type"`
+	Token  string       `schema:"token"`
+	TxHash string       `schema:"tx_hash"`
+	Type   []string     `schema:"type"`
+	From   smodels.Time `schema:"from"`
+	To     smodels.Time `schema:"to"`
 	Pagination
 }
@@ -1,5 +1,46 @@
 package filters
 
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+)
+
+const maxRankingBuckets = 20
+
 type Validators struct {
 	Pagination
 }
+
+type ValidatorEpochs struct {
+	Keys []string
+	// Epochs is the number of the last recorded epochs
+	Epochs uint64
+}
+
+type Ranking struct {
+	// Buckets are the lower boundaries of the ranges (excluding zero) in EGLD
+	Buckets []float64 `schema:"buckets"`
+}
+
+func (r *Ranking) Validate() error {
+	if len(r.Buckets) > maxRankingBuckets {
+		return fmt.Errorf("too many buckets")
+	}
+	for i, b := range r.Buckets {
+		if b <= 0 || (i > 0 && b <= r.Buckets[i-1]) {
+			return fmt.Errorf("buckets must be positive and ascending")
+		}
+	}
+	return nil
+}
+
+type RankingHistory struct {
+	Provider string       `schema:"provider"`
+	From     smodels.Time `schema:"from"`
+	To       smodels.Time `schema:"to"`
+}
+
+type ProviderDelegators struct {
+	Provider string `schema:"-"`
+	Pagination
+}
@@ -0,0 +1,252 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/ElrondNetwork/elastic-indexer-go/data"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/derrors"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/services/es"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+func (db Postgres) UpdateAccounts(accounts []dmodels.Account) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.AccountsTable).Columns(
+		"acc_address",
+		"acc_balance",
+		"acc_nonce",
+		"acc_created_at",
+		"acc_updated_at",
+	)
+	for _, acc := range accounts {
+		if acc.Address == "" {
+			return fmt.Errorf("field Address is empty")
+		}
+		if acc.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
+		q = q.Values(
+			acc.Address,
+			acc.Balance,
+			acc.Nonce,
+			acc.CreatedAt,
+			acc.UpdatedAt,
+		)
+	}
+	q = q.Suffix(`ON CONFLICT (acc_address) DO UPDATE SET
+		acc_balance = excluded.acc_balance,
+		acc_nonce = excluded.acc_nonce,
+		acc_updated_at = excluded.acc_updated_at`)
+	_, err := db.insert(q)
+	return err
+}
+
+// UpdateESDTAccounts upserts the given balances, accounts with zero balance are removed
+func (db Postgres) UpdateESDTAccounts(accounts []dmodels.ESDTAccount) error {
+	var nonEmpty []dmodels.ESDTAccount
+	for _, acc := range accounts {
+		if acc.Balance.IsZero() {
+			q := squirrel.Delete(dmodels.ESDTAccountsTable).Where(squirrel.Eq{
+				"esa_address":     acc.Address,
+				"esa_token":       acc.Token,
+				"esa_token_nonce": acc.TokenNonce,
+			})
+			err := db.delete(q)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		nonEmpty = append(nonEmpty, acc)
+	}
+	if len(nonEmpty) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.ESDTAccountsTable).Columns(
+		"esa_address",
+		"esa_token",
+		"esa_token_nonce",
+		"esa_balance",
+		"esa_updated_at",
+	)
+	for _, acc := range nonEmpty {
+		if acc.Address == "" {
+			return fmt.Errorf("field Address is empty")
+		}
+		if acc.Token == "" {
+			return fmt.Errorf("field Token is empty")
+		}
+		q = q.Values(
+			acc.Address,
+			acc.Token,
+			acc.TokenNonce,
+			acc.Balance,
+			acc.UpdatedAt,
+		)
+	}
+	q = q.Suffix(`ON CONFLICT (esa_address, esa_token, esa_token_nonce) DO UPDATE SET
+		esa_balance = excluded.esa_balance,
+		esa_updated_at = excluded.esa_updated_at`)
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) GetAccount(address string) (acc data.AccountInfo, err error) {
+	q := squirrel.Select("*").From(dmodels.AccountsTable).Where(squirrel.Eq{"acc_address": address})
+	var item dmodels.Account
+	err = db.first(&item, q)
+	if err != nil {
+		if err.Error() == NoRowsError {
+			return acc, derrors.NotFound
+		}
+		return acc, err
+	}
+	return toDataAccount(item), nil
+}
+
+func (db Postgres) GetAccounts(filter filters.Accounts) (accounts []data.AccountInfo, err error) {
+	q := squirrel.Select("*").From(dmodels.AccountsTable).OrderBy(fmt.Sprintf("acc_balance %s", filter.SortOrder()))
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset() != 0 {
+		q = q.Offset(filter.Offset())
+	}
+	var items []dmodels.Account
+	err = db.find(&items, q)
+	if err != nil {
+		return nil, err
+	}
+	accounts = make([]data.AccountInfo, len(items))
+	for i, item := range items {
+		accounts[i] = toDataAccount(item)
+	}
+	return accounts, nil
+}
+
+func (db Postgres) GetAccountsCount(filter filters.Accounts) (total uint64, err error) {
+	q := squirrel.Select("count(*) as total").From(dmodels.AccountsTable)
+	err = db.first(&total, q)
+	return total, err
+}
+
+func (db Postgres) GetAccountsDistribution(buckets []es.BalanceBucket) ([]es.BalanceBucket, error) {
+	result := make([]es.BalanceBucket, len(buckets))
+	for i, b := range buckets {
+		q := squirrel.Select("count(*) as count", "coalesce(sum(acc_balance), 0) as amount").
+			From(dmodels.AccountsTable).
+			Where(squirrel.GtOrEq{"acc_balance": b.From.Shift(18)})
+		if !b.To.IsZero() {
+			q = q.Where(squirrel.Lt{"acc_balance": b.To.Shift(18)})
+		}
+		var item struct {
+			Count  uint64          `db:"count"`
+			Amount decimal.Decimal `db:"amount"`
+		}
+		err := db.first(&item, q)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = b
+		result[i].Count = item.Count
+		result[i].Amount = item.Amount.Shift(-18)
+	}
+	return result, nil
+}
+
+func (db Postgres) GetESDTAccounts(filter filters.ESDT) (accounts []es.AccountESDT, err error) {
+	q := squirrel.Select("*").From(dmodels.ESDTAccountsTable).OrderBy("esa_balance desc")
+	if filter.NFT {
+		q = squirrel.Select("*").From(dmodels.ESDTAccountsTable).OrderBy("esa_token", "esa_token_nonce")
+	}
+	q = esdtAccountsFilter(q, filter)
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset() != 0 {
+		q = q.Offset(filter.Offset())
+	}
+	var items []dmodels.ESDTAccount
+	err = db.find(&items, q)
+	if err != nil {
+		return nil, err
+	}
+	accounts = make([]es.AccountESDT, len(items))
+	for i, item := range items {
+		accounts[i] = es.AccountESDT{
+			Address:    item.Address,
+			Balance:    item.Balance,
+			BalanceNum: item.Balance,
+			Token:      item.Token,
+			TokenNonce: item.TokenNonce,
+		}
+	}
+	return accounts, nil
+}
+
+func (db Postgres) GetESDTAccountsCount(filter filters.ESDT) (total uint64, err error) {
+	q := squirrel.Select("count(*) as total").From(dmodels.ESDTAccountsTable)
+	q = esdtAccountsFilter(q, filter)
+	err = db.first(&total, q)
+	return total, err
+}
+
+func (db Postgres) GetESDTHoldersCount(filter filters.ESDT) (total uint64, err error) {
+	q := squirrel.Select("count(distinct esa_address) as total").From(dmodels.ESDTAccountsTable)
+	q = esdtAccountsFilter(q, filter)
+	err = db.first(&total, q)
+	return total, err
+}
+
+// GetESDTCollections returns the distinct tokens of the matched balances ordered by the identifier, the pagination is applied to the tokens
+func (db Postgres) GetESDTCollections(filter filters.ESDT) (collections []string, err error) {
+	q := squirrel.Select("distinct esa_token").From(dmodels.ESDTAccountsTable).OrderBy("esa_token")
+	q = esdtAccountsFilter(q, filter)
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset() != 0 {
+		q = q.Offset(filter.Offset())
+	}
+	err = db.find(&collections, q)
+	return collections, err
+}
+
+func (db Postgres) GetESDTCollectionsCount(filter filters.ESDT) (total uint64, err error) {
+	q := squirrel.Select("count(distinct esa_token) as total").From(dmodels.ESDTAccountsTable)
+	q = esdtAccountsFilter(q, filter)
+	err = db.first(&total, q)
+	return total, err
+}
+
+func esdtAccountsFilter(q squirrel.SelectBuilder, filter filters.ESDT) squirrel.SelectBuilder {
+	if filter.TokenIdentifier != "" {
+		q = q.Where(squirrel.Eq{"esa_token": filter.TokenIdentifier})
+	}
+	if filter.Address != "" {
+		q = q.Where(squirrel.Eq{"esa_address": filter.Address})
+	}
+	if filter.TokenNonce != 0 {
+		q = q.Where(squirrel.Eq{"esa_token_nonce": filter.TokenNonce})
+	}
+	if filter.NFT {
+		q = q.Where(squirrel.Gt{"esa_token_nonce": 0})
+	}
+	return q
+}
+
+func toDataAccount(acc dmodels.Account) data.AccountInfo {
+	balanceNum, _ := acc.Balance.Shift(-18).Float64()
+	return data.AccountInfo{
+		Address:    acc.Address,
+		Nonce:      acc.Nonce,
+		Balance:    acc.Balance.String(),
+		BalanceNum: balanceNum,
+		Timestamp:  time.Duration(acc.UpdatedAt.Unix()),
+	}
+}
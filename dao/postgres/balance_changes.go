@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/shopspring/decimal"
+)
+
+func (db Postgres) CreateBalanceChanges(changes []dmodels.BalanceChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.BalanceChangesTable).Columns(
+		"bch_address",
+		"bch_token",
+		"bch_block",
+		"bch_amount",
+		"bch_created_at",
+	)
+	for _, c := range changes {
+		if c.Address == "" {
+			return fmt.Errorf("field Address is empty")
+		}
+		if c.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
+		q = q.Values(
+			c.Address,
+			c.Token,
+			c.Block,
+			c.Amount,
+			c.CreatedAt,
+		)
+	}
+	q = q.Suffix("ON CONFLICT (bch_address, bch_token, bch_block) DO NOTHING")
+	_, err := db.insert(q)
+	return err
+}
+
+// GetBalanceChangesSums returns sums of balance changes grouped by filter.Interval
+func (db Postgres) GetBalanceChangesSums(filter filters.BalanceHistory) (items []dmodels.BalanceChangesSum, err error) {
+	q := squirrel.Select(fmt.Sprintf("date_trunc('%s', bch_created_at) as time", filter.Interval), "sum(bch_amount) as amount").
+		From(dmodels.BalanceChangesTable).
+		Where(squirrel.Eq{"bch_address": filter.Address, "bch_token": filter.Token}).
+		Where(squirrel.GtOrEq{"bch_created_at": filter.From}).
+		Where(squirrel.LtOrEq{"bch_created_at": filter.To}).
+		GroupBy("time").
+		OrderBy("time")
+	err = db.find(&items, q)
+	return items, err
+}
+
+// GetBalanceChangesTotal returns sum of balance changes after filter.To
+func (db Postgres) GetBalanceChangesTotal(filter filters.BalanceHistory) (total decimal.Decimal, err error) {
+	q := squirrel.Select("coalesce(sum(bch_amount), 0) as amount").
+		From(dmodels.BalanceChangesTable).
+		Where(squirrel.Eq{"bch_address": filter.Address, "bch_token": filter.Token}).
+		Where(squirrel.Gt{"bch_created_at": filter.To})
+	err = db.first(&total, q)
+	return total, err
+}
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/ElrondNetwork/elastic-indexer-go/data"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/derrors"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"time"
+)
+
+func (db Postgres) CreateBlocks(blocks []dmodels.Block) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.BlocksTable).Columns(
+		"blk_hash",
+		"blk_nonce",
+		"blk_round",
+		"blk_shard",
+		"blk_num_txs",
+		"blk_epoch",
+		"blk_status",
+		"blk_prev_block_hash",
+		"blk_miniblocks",
+		"blk_accumulated_fees",
+		"blk_developer_fees",
+		"blk_created_at",
+	)
+	for _, b := range blocks {
+		if b.Hash == "" {
+			return fmt.Errorf("field Hash is empty")
+		}
+		if b.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
+		q = q.Values(
+			b.Hash,
+			b.Nonce,
+			b.Round,
+			b.Shard,
+			b.NumTxs,
+			b.Epoch,
+			b.Status,
+			b.PrevBlockHash,
+			b.MiniBlocks,
+			b.AccumulatedFees,
+			b.DeveloperFees,
+			b.CreatedAt,
+		)
+	}
+	q = q.Suffix("ON CONFLICT (blk_hash) DO NOTHING")
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) CreateMiniBlocks(miniblocks []dmodels.MiniBlock) error {
+	if len(miniblocks) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.MiniBlocksTable).Columns(
+		"mlk_hash",
+		"mlk_receiver_block_hash",
+		"mlk_receiver_shard",
+		"mlk_sender_block_hash",
+		"mlk_sender_shard",
+		"mlk_type",
+		"mlk_created_at",
+	)
+	for _, mb := range miniblocks {
+		if mb.Hash == "" {
+			return fmt.Errorf("field Hash is empty")
+		}
+		if mb.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
+		q = q.Values(
+			mb.Hash,
+			mb.ReceiverBlockHash,
+			mb.ReceiverShard,
+			mb.SenderBlockHash,
+			mb.SenderShard,
+			mb.Type,
+			mb.CreatedAt,
+		)
+	}
+	// cross-shard miniblocks are seen twice, in the sender and in the receiver block
+	q = q.Suffix(`ON CONFLICT (mlk_hash) DO UPDATE SET
+		mlk_receiver_block_hash = greatest(miniblocks.mlk_receiver_block_hash, excluded.mlk_receiver_block_hash),
+		mlk_sender_block_hash = greatest(miniblocks.mlk_sender_block_hash, excluded.mlk_sender_block_hash)`)
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) GetBlock(hash string) (block data.Block, err error) {
+	q := squirrel.Select("*").From(dmodels.BlocksTable).Where(squirrel.Eq{"blk_hash": hash})
+	var b dmodels.Block
+	err = db.first(&b, q)
+	if err != nil {
+		if err.Error() == NoRowsError {
+			return block, derrors.NotFound
+		}
+		return block, err
+	}
+	return toDataBlock(b), nil
+}
+
+func (db Postgres) GetBlocks(filter filters.Blocks) (blocks []data.Block, err error) {
+	q := squirrel.Select("*").From(dmodels.BlocksTable).OrderBy("blk_created_at desc")
+	if filter.Nonce != 0 && len(filter.Shard) != 0 {
+		q = q.Where(squirrel.Eq{"blk_nonce": filter.Nonce, "blk_shard": filter.Shard[0]})
+	}
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset() != 0 {
+		q = q.Offset(filter.Offset())
+	}
+	var items []dmodels.Block
+	err = db.find(&items, q)
+	if err != nil {
+		return nil, err
+	}
+	blocks = make([]data.Block, len(items))
+	for i, b := range items {
+		blocks[i] = toDataBlock(b)
+	}
+	return blocks, nil
+}
+
+func (db Postgres) GetBlocksCount(filter filters.Blocks) (total uint64, err error) {
+	q := squirrel.Select("count(*) as total").From(dmodels.BlocksTable)
+	if filter.Nonce != 0 {
+		q = q.Where(squirrel.Eq{"blk_nonce": filter.Nonce})
+	}
+	if len(filter.Shard) > 0 {
+		q = q.Where(squirrel.Eq{"blk_shard": filter.Shard[0]})
+	}
+	err = db.first(&total, q)
+	return total, err
+}
+
+func (db Postgres) GetMiniblock(hash string) (miniblock data.Miniblock, err error) {
+	q := squirrel.Select("*").From(dmodels.MiniBlocksTable).Where(squirrel.Eq{"mlk_hash": hash})
+	var mb dmodels.MiniBlock
+	err = db.first(&mb, q)
+	if err != nil {
+		if err.Error() == NoRowsError {
+			return miniblock, derrors.NotFound
+		}
+		return miniblock, err
+	}
+	return data.Miniblock{
+		Hash:              mb.Hash,
+		SenderShardID:     uint32(mb.SenderShard),
+		ReceiverShardID:   uint32(mb.ReceiverShard),
+		SenderBlockHash:   mb.SenderBlockHash,
+		ReceiverBlockHash: mb.ReceiverBlockHash,
+		Type:              mb.Type,
+		Timestamp:         time.Duration(mb.CreatedAt.Unix()),
+	}, nil
+}
+
+// ValidatorsKeys isn't supported, the consensus group is not available from the node proxy
+func (db Postgres) ValidatorsKeys(shard uint64, epoch uint64) (keys data.ValidatorsPublicKeys, err error) {
+	return keys, derrors.NotSupported
+}
+
+func toDataBlock(b dmodels.Block) data.Block {
+	return data.Block{
+		Hash:             b.Hash,
+		Nonce:            b.Nonce,
+		Round:            b.Round,
+		Epoch:            uint32(b.Epoch),
+		MiniBlocksHashes: b.MiniBlocks,
+		Timestamp:        time.Duration(b.CreatedAt.Unix()),
+		PrevHash:         b.PrevBlockHash,
+		ShardID:          uint32(b.Shard),
+		TxCount:          uint32(b.NumTxs),
+		AccumulatedFees:  b.AccumulatedFees.String(),
+		DeveloperFees:    b.DeveloperFees.String(),
+	}
+}
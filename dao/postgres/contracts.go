@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"time"
+)
+
+var contractsColumns = []string{
+	"ctr_address",
+	"ctr_deployer",
+	"ctr_owner",
+	"ctr_code_hash",
+	"ctr_deploy_tx_hash",
+	"ctr_deployed_at",
+	"ctr_updated_at",
+}
+
+func contractValues(c dmodels.Contract) []interface{} {
+	return []interface{}{
+		c.Address,
+		c.Deployer,
+		c.Owner,
+		c.CodeHash,
+		c.DeployTxHash,
+		c.DeployedAt,
+		c.UpdatedAt,
+	}
+}
+
+// CreateContracts saves deployed contracts, the placeholders of the contracts seen before get the deploy info
+func (db Postgres) CreateContracts(contracts []dmodels.Contract) error {
+	if len(contracts) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.ContractsTable).Columns(contractsColumns...)
+	for _, c := range contracts {
+		if c.Address == "" {
+			return fmt.Errorf("field Address is empty")
+		}
+		q = q.Values(contractValues(c)...)
+	}
+	q = q.Suffix(`ON CONFLICT (ctr_address) DO UPDATE SET
+		ctr_deployer = excluded.ctr_deployer,
+		ctr_deploy_tx_hash = excluded.ctr_deploy_tx_hash,
+		ctr_deployed_at = excluded.ctr_deployed_at
+		WHERE contracts.ctr_deploy_tx_hash = ''`)
+	_, err := db.insert(q)
+	return err
+}
+
+// CreateContractPlaceholders adds the contracts deployed before the parsed range
+func (db Postgres) CreateContractPlaceholders(addresses []string) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.ContractsTable).Columns(contractsColumns...)
+	for _, address := range addresses {
+		if address == "" {
+			return fmt.Errorf("address is empty")
+		}
+		q = q.Values(contractValues(dmodels.Contract{
+			Address:    address,
+			DeployedAt: time.Unix(0, 0),
+			UpdatedAt:  time.Unix(0, 0),
+		})...)
+	}
+	q = q.Suffix("ON CONFLICT (ctr_address) DO NOTHING")
+	_, err := db.insert(q)
+	return err
+}
+
+// UpgradeContract sets the new code hash, the same upgrade tx is applied only once
+func (db Postgres) UpgradeContract(contract dmodels.Contract) error {
+	q := squirrel.Insert(dmodels.ContractsTable).
+		Columns(append(contractsColumns, "ctr_upgrade_tx_hash", "ctr_upgrades")...).
+		Values(append(contractValues(contract), contract.UpgradeTxHash, 1)...).
+		Suffix(`ON CONFLICT (ctr_address) DO UPDATE SET
+		ctr_owner = excluded.ctr_owner,
+		ctr_code_hash = excluded.ctr_code_hash,
+		ctr_upgrade_tx_hash = excluded.ctr_upgrade_tx_hash,
+		ctr_upgrades = contracts.ctr_upgrades + 1,
+		ctr_updated_at = excluded.ctr_updated_at
+		WHERE contracts.ctr_upgrade_tx_hash <> excluded.ctr_upgrade_tx_hash`)
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) UpdateContractOwner(contract dmodels.Contract) error {
+	q := squirrel.Insert(dmodels.ContractsTable).
+		Columns(contractsColumns...).
+		Values(contractValues(contract)...).
+		Suffix(`ON CONFLICT (ctr_address) DO UPDATE SET
+		ctr_owner = excluded.ctr_owner,
+		ctr_updated_at = excluded.ctr_updated_at`)
+	_, err := db.insert(q)
+	return err
+}
+
+// CreateContractCalls adds calls to the counters of the contract functions, the last applied block is kept per function
+func (db Postgres) CreateContractCalls(calls []dmodels.ContractCall) error {
+	if len(calls) == 0 {
+		return nil
+	}
+	rows := make([][]interface{}, len(calls))
+	for i, c := range calls {
+		if c.Contract == "" {
+			return fmt.Errorf("field Contract is empty")
+		}
+		rows[i] = []interface{}{c.Contract, c.Function, c.Calls, c.Block, c.LastCallAt}
+	}
+	q := squirrel.Insert(dmodels.ContractCallsTable).
+		PrefixExpr(withValues("v",
+			[]string{"contract", "function", "calls", "block", "last_call_at"},
+			[]string{"varchar", "varchar", "bigint", "bigint", "timestamp"},
+			rows,
+		)).
+		Columns(
+			"ccl_contract",
+			"ccl_function",
+			"ccl_calls",
+			"ccl_block",
+			"ccl_last_call_at",
+		).
+		Select(squirrel.Select("v.contract", "v.function", "sum(v.calls)", "max(v.block)", "max(v.last_call_at)").
+			From("v").
+			LeftJoin(fmt.Sprintf("%s c on c.ccl_contract = v.contract and c.ccl_function = v.function", dmodels.ContractCallsTable)).
+			Where("v.block > coalesce(c.ccl_block, -1)").
+			GroupBy("v.contract", "v.function")).
+		Suffix(`ON CONFLICT (ccl_contract, ccl_function) DO UPDATE SET
+		ccl_calls = contract_calls.ccl_calls + excluded.ccl_calls,
+		ccl_block = excluded.ccl_block,
+		ccl_last_call_at = greatest(contract_calls.ccl_last_call_at, excluded.ccl_last_call_at)`)
+	_, err := db.insert(q)
+	return err
+}
+
+func contractsActivityQuery() squirrel.SelectBuilder {
+	return squirrel.Select(
+		"contracts.*",
+		"coalesce(sum(ccl_calls), 0) as calls",
+		"coalesce(max(ccl_last_call_at), contracts.ctr_updated_at) as last_call_at",
+	).From(dmodels.ContractsTable).
+		LeftJoin(fmt.Sprintf("%s on ccl_contract = ctr_address", dmodels.ContractCallsTable)).
+		GroupBy("ctr_address")
+}
+
+// GetContracts returns contracts ordered by the number of calls
+func (db Postgres) GetContracts(filter filters.Contracts) (contracts []dmodels.ContractActivity, err error) {
+	q := contractsActivityQuery().OrderBy("calls desc", "ctr_address")
+	if filter.Deployer != "" {
+		q = q.Where(squirrel.Eq{"ctr_deployer": filter.Deployer})
+	}
+	if filter.Owner != "" {
+		q = q.Where(squirrel.Eq{"ctr_owner": filter.Owner})
+	}
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset() != 0 {
+		q = q.Offset(filter.Offset())
+	}
+	err = db.find(&contracts, q)
+	return contracts, err
+}
+
+func (db Postgres) GetContractsTotal(filter filters.Contracts) (total uint64, err error) {
+	q := squirrel.Select("count(*) as total").From(dmodels.ContractsTable)
+	if filter.Deployer != "" {
+		q = q.Where(squirrel.Eq{"ctr_deployer": filter.Deployer})
+	}
+	if filter.Owner != "" {
+		q = q.Where(squirrel.Eq{"ctr_owner": filter.Owner})
+	}
+	err = db.first(&total, q)
+	return total, err
+}
+
+func (db Postgres) GetContract(address string) (contract dmodels.ContractActivity, err error) {
+	q := contractsActivityQuery().Where(squirrel.Eq{"ctr_address": address})
+	err = db.first(&contract, q)
+	return contract, err
+}
+
+// GetContractCalls returns the functions of the contract ordered by the number of calls
+func (db Postgres) GetContractCalls(filter filters.ContractCalls) (calls []dmodels.ContractCall, err error) {
+	q := squirrel.Select("*").
+		From(dmodels.ContractCallsTable).
+		Where(squirrel.Eq{"ccl_contract": filter.Contract}).
+		OrderBy("ccl_calls desc", "ccl_function")
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset() != 0 {
+		q = q.Offset(filter.Offset())
+	}
+	err = db.find(&calls, q)
+	return calls, err
+}
+
+func (db Postgres) GetContractCallsTotal(filter filters.ContractCalls) (total uint64, err error) {
+	q := squirrel.Select("count(*) as total").
+		From(dmodels.ContractCallsTable).
+		Where(squirrel.Eq{"ccl_contract": filter.Contract})
+	err = db.first(&total, q)
+	return total, err
+}
+
+func (db Postgres) UpdateContractABI(contractABI dmodels.ContractABI) error {
+	q := squirrel.Insert(dmodels.ContractABIsTable).Columns(
+		"cab_address",
+		"cab_abi",
+		"cab_created_at",
+	).Values(
+		contractABI.Address,
+		contractABI.ABI,
+		contractABI.CreatedAt,
+	).Suffix("ON CONFLICT (cab_address) DO UPDATE SET cab_abi = excluded.cab_abi, cab_created_at = excluded.cab_created_at")
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) GetContractABIs() (items []dmodels.ContractABI, err error) {
+	q := squirrel.Select("*").From(dmodels.ContractABIsTable)
+	err = db.find(&items, q)
+	return items, err
+}
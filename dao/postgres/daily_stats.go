@@ -50,3 +50,23 @@ func (db Postgres) GetDailyStatsRange(filter filters.DailyStats) (items []dmodel
 	err = db.find(&items, q)
 	return items, err
 }
+
+// GetDailyStatsSums sums values of the stats which titles start with the filter key, the biggest sums go first
+func (db Postgres) GetDailyStatsSums(filter filters.DailyStats) (items []dmodels.DailyStat, err error) {
+	q := squirrel.Select("das_title", "sum(das_value) as das_value").
+		From(dmodels.DailyStatsTable).
+		Where(squirrel.Like{"das_title": filter.Key + "%"}).
+		GroupBy("das_title").
+		OrderBy("sum(das_value) desc")
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if !filter.From.IsZero() {
+		q = q.Where(squirrel.GtOrEq{"das_created_at": filter.From})
+	}
+	if !filter.To.IsZero() {
+		q = q.Where(squirrel.LtOrEq{"das_created_at": filter.To})
+	}
+	err = db.find(&items, q)
+	return items, err
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
+	"strings"
 )
 
 const (
@@ -129,6 +130,24 @@ func (db Postgres) delete(sb squirrel.DeleteBuilder) (err error) {
 	return nil
 }
 
+// withValues makes the CTE of the rows passed as the VALUES list, the parameters of every column are cast to its type.
+// The counters are inserted from the CTE joined to the counter itself, the rows of the blocks up to the last applied one
+// are filtered out before the sum, so the parser may replay a batch with other boundaries and nothing is counted twice
+func withValues(name string, columns []string, types []string, rows [][]interface{}) squirrel.Sqlizer {
+	casts := make([]string, len(types))
+	for i, t := range types {
+		casts[i] = fmt.Sprintf("?::%s", t)
+	}
+	tuple := fmt.Sprintf("(%s)", strings.Join(casts, ", "))
+	values := make([]string, len(rows))
+	var args []interface{}
+	for i, row := range rows {
+		values[i] = tuple
+		args = append(args, row...)
+	}
+	return squirrel.Expr(fmt.Sprintf("WITH %s (%s) AS (VALUES %s)", name, strings.Join(columns, ", "), strings.Join(values, ", ")), args...)
+}
+
 func (db Postgres) makeMigration(conn *sql.DB, migrationDir string) error {
 	driver, err := postgres.WithInstance(conn, &postgres.Config{
 		DatabaseName: db.cfg.Database,
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+)
+
+// GetDelegationsToReconcile returns the known delegators with the parsed stake and the current drift,
+// the delegators which have never been checked go first and then the least recently checked ones
+func (db Postgres) GetDelegationsToReconcile(limit uint64) (items []dmodels.DelegationCorrection, err error) {
+	parsed := squirrel.Select(
+		"ste_validator",
+		"ste_delegator",
+		fmt.Sprintf("coalesce(sum(ste_amount) filter (where ste_type in ('%s', '%s')), 0) as amount",
+			dmodels.DelegateStakeEventType, dmodels.UnDelegateStakeEventType),
+	).From(dmodels.StakeEventsTable).
+		Where(squirrel.Eq{"ste_type": []string{
+			dmodels.DelegateStakeEventType,
+			dmodels.UnDelegateStakeEventType,
+			dmodels.ReDelegateRewardsEventType,
+			dmodels.ClaimRewardsEventType,
+			dmodels.WithdrawEventType,
+		}}).
+		GroupBy("ste_validator", "ste_delegator")
+	q := squirrel.Select(
+		"s.ste_validator as dlc_provider",
+		"s.ste_delegator as dlc_delegator",
+		"s.amount as dlc_parsed",
+		"coalesce(c.dlc_drift, 0) as dlc_drift",
+	).FromSelect(parsed, "s").
+		LeftJoin(fmt.Sprintf("%s c on c.dlc_provider = s.ste_validator and c.dlc_delegator = s.ste_delegator", dmodels.DelegationCorrectionsTable)).
+		OrderBy("c.dlc_checked_at nulls first").
+		Limit(limit)
+	err = db.find(&items, q)
+	return items, err
+}
+
+func (db Postgres) UpdateDelegationCorrections(items []dmodels.DelegationCorrection) error {
+	if len(items) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.DelegationCorrectionsTable).Columns(
+		"dlc_provider",
+		"dlc_delegator",
+		"dlc_parsed",
+		"dlc_on_chain",
+		"dlc_drift",
+		"dlc_checked_at",
+	)
+	for _, item := range items {
+		if item.Provider == "" || item.Delegator == "" {
+			return fmt.Errorf("field Provider or Delegator is empty")
+		}
+		if item.CheckedAt.IsZero() {
+			return fmt.Errorf("field CheckedAt is empty")
+		}
+		q = q.Values(
+			item.Provider,
+			item.Delegator,
+			item.Parsed,
+			item.OnChain,
+			item.Drift,
+			item.CheckedAt,
+		)
+	}
+	q = q.Suffix(`ON CONFLICT (dlc_provider, dlc_delegator) DO UPDATE SET
+		dlc_parsed = excluded.dlc_parsed,
+		dlc_on_chain = excluded.dlc_on_chain,
+		dlc_drift = excluded.dlc_drift,
+		dlc_checked_at = excluded.dlc_checked_at`)
+	_, err := db.insert(q)
+	return err
+}
+
+// GetDelegationDrifts returns the corrections with non-zero drift, the largest drifts go first
+func (db Postgres) GetDelegationDrifts(filter filters.DelegationDrifts) (items []dmodels.DelegationCorrection, err error) {
+	q := squirrel.Select("*").
+		From(dmodels.DelegationCorrectionsTable).
+		Where(squirrel.NotEq{"dlc_drift": 0}).
+		OrderBy("abs(dlc_drift) desc")
+	if filter.Provider != "" {
+		q = q.Where(squirrel.Eq{"dlc_provider": filter.Provider})
+	}
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset() != 0 {
+		q = q.Offset(filter.Offset())
+	}
+	err = db.find(&items, q)
+	return items, err
+}
+
+func (db Postgres) GetDelegationDriftsTotal(filter filters.DelegationDrifts) (total uint64, err error) {
+	q := squirrel.Select("count(*)").
+		From(dmodels.DelegationCorrectionsTable).
+		Where(squirrel.NotEq{"dlc_drift": 0})
+	if filter.Provider != "" {
+		q = q.Where(squirrel.Eq{"dlc_provider": filter.Provider})
+	}
+	err = db.first(&total, q)
+	return total, err
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+)
+
+// CreateEpochs adds hyperblocks to the epoch counters, every item holds one hyperblock,
+// the end nonce of the epoch is its last applied hyperblock
+func (db Postgres) CreateEpochs(epochs []dmodels.Epoch) error {
+	if len(epochs) == 0 {
+		return nil
+	}
+	rows := make([][]interface{}, len(epochs))
+	for i, e := range epochs {
+		if e.StartTime.IsZero() {
+			return fmt.Errorf("field StartTime is empty")
+		}
+		rows[i] = []interface{}{e.Epoch, e.StartNonce, e.EndNonce, e.StartTime, e.EndTime, e.Txs, e.Fees, e.Rewards}
+	}
+	q := squirrel.Insert(dmodels.EpochsTable).
+		PrefixExpr(withValues("v",
+			[]string{"epoch", "start_nonce", "end_nonce", "start_time", "end_time", "txs", "fees", "rewards"},
+			[]string{"bigint", "bigint", "bigint", "timestamp", "timestamp", "bigint", "numeric", "numeric"},
+			rows,
+		)).
+		Columns(
+			"epc_epoch",
+			"epc_start_nonce",
+			"epc_end_nonce",
+			"epc_start_time",
+			"epc_end_time",
+			"epc_txs",
+			"epc_fees",
+			"epc_rewards",
+		).
+		Select(squirrel.Select(
+			"v.epoch",
+			"min(v.start_nonce)",
+			"max(v.end_nonce)",
+			"min(v.start_time)",
+			"max(v.end_time)",
+			"sum(v.txs)",
+			"sum(v.fees)",
+			"sum(v.rewards)",
+		).
+			From("v").
+			LeftJoin(fmt.Sprintf("%s e on e.epc_epoch = v.epoch", dmodels.EpochsTable)).
+			Where("v.start_nonce > coalesce(e.epc_end_nonce, -1)").
+			GroupBy("v.epoch")).
+		Suffix(`ON CONFLICT (epc_epoch) DO UPDATE SET
+		epc_start_nonce = least(epochs.epc_start_nonce, excluded.epc_start_nonce),
+		epc_end_nonce = greatest(epochs.epc_end_nonce, excluded.epc_end_nonce),
+		epc_start_time = least(epochs.epc_start_time, excluded.epc_start_time),
+		epc_end_time = greatest(epochs.epc_end_time, excluded.epc_end_time),
+		epc_txs = epochs.epc_txs + excluded.epc_txs,
+		epc_fees = epochs.epc_fees + excluded.epc_fees,
+		epc_rewards = epochs.epc_rewards + excluded.epc_rewards`)
+	_, err := db.insert(q)
+	return err
+}
+
+// CreateEpochSnapshot keeps the first snapshot of the epoch
+func (db Postgres) CreateEpochSnapshot(snapshot dmodels.EpochSnapshot) error {
+	if snapshot.CreatedAt.IsZero() {
+		return fmt.Errorf("field CreatedAt is empty")
+	}
+	q := squirrel.Insert(dmodels.EpochSnapshotsTable).Columns(
+		"eps_epoch",
+		"eps_validators",
+		"eps_stake",
+		"eps_top_up",
+		"eps_shards",
+		"eps_created_at",
+	).Values(
+		snapshot.Epoch,
+		snapshot.Validators,
+		snapshot.Stake,
+		snapshot.TopUp,
+		snapshot.Shards,
+		snapshot.CreatedAt,
+	).Suffix("ON CONFLICT (eps_epoch) DO NOTHING")
+	_, err := db.insert(q)
+	return err
+}
+
+func epochSummaryQuery() squirrel.SelectBuilder {
+	return squirrel.Select(
+		"epochs.*",
+		"coalesce(eps_validators, 0) as eps_validators",
+		"coalesce(eps_stake, 0) as eps_stake",
+		"coalesce(eps_top_up, 0) as eps_top_up",
+		"coalesce(eps_shards, 0) as eps_shards",
+	).From(dmodels.EpochsTable).
+		LeftJoin(fmt.Sprintf("%s on eps_epoch = epc_epoch", dmodels.EpochSnapshotsTable))
+}
+
+func (db Postgres) GetEpochs(filter filters.Epochs) (epochs []dmodels.EpochSummary, err error) {
+	q := epochSummaryQuery().OrderBy("epc_epoch desc")
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset() != 0 {
+		q = q.Offset(filter.Offset())
+	}
+	err = db.find(&epochs, q)
+	return epochs, err
+}
+
+func (db Postgres) GetEpochsTotal(filter filters.Epochs) (total uint64, err error) {
+	q := squirrel.Select("count(*) as total").From(dmodels.EpochsTable)
+	err = db.first(&total, q)
+	return total, err
+}
+
+func (db Postgres) GetEpochSummary(epoch uint64) (summary dmodels.EpochSummary, err error) {
+	q := epochSummaryQuery().Where(squirrel.Eq{"epc_epoch": epoch})
+	err = db.first(&summary, q)
+	return summary, err
+}
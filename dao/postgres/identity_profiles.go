@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+)
+
+func (db Postgres) GetIdentityProfile(identity string) (profile dmodels.IdentityProfile, err error) {
+	q := squirrel.Select("*").
+		From(dmodels.IdentityProfilesTable).
+		Where(squirrel.Eq{"idp_identity": identity})
+	err = db.first(&profile, q)
+	return profile, err
+}
+
+// UpdateIdentityProfile caches the resolved profile, the overridden profile is updated only by another override
+func (db Postgres) UpdateIdentityProfile(profile dmodels.IdentityProfile) error {
+	if profile.Identity == "" {
+		return fmt.Errorf("field Identity is empty")
+	}
+	if profile.UpdatedAt.IsZero() {
+		return fmt.Errorf("field UpdatedAt is empty")
+	}
+	q := squirrel.Insert(dmodels.IdentityProfilesTable).Columns(
+		"idp_identity",
+		"idp_name",
+		"idp_avatar",
+		"idp_description",
+		"idp_website",
+		"idp_source",
+		"idp_override",
+		"idp_updated_at",
+	).Values(
+		profile.Identity,
+		profile.Name,
+		profile.Avatar,
+		profile.Description,
+		profile.Website,
+		profile.Source,
+		profile.Override,
+		profile.UpdatedAt,
+	).Suffix(`ON CONFLICT (idp_identity) DO UPDATE SET
+		idp_name = excluded.idp_name,
+		idp_avatar = excluded.idp_avatar,
+		idp_description = excluded.idp_description,
+		idp_website = excluded.idp_website,
+		idp_source = excluded.idp_source,
+		idp_override = excluded.idp_override,
+		idp_updated_at = excluded.idp_updated_at
+		WHERE excluded.idp_override OR NOT identity_profiles.idp_override`)
+	_, err := db.insert(q)
+	return err
+}
+
+// DeleteIdentityProfileOverride removes the overridden profile, the profile is resolved again on the next update
+func (db Postgres) DeleteIdentityProfileOverride(identity string) error {
+	q := squirrel.Delete(dmodels.IdentityProfilesTable).Where(squirrel.Eq{
+		"idp_identity": identity,
+		"idp_override": true,
+	})
+	return db.delete(q)
+}
@@ -0,0 +1,9 @@
+-- +migrate Up
+create table token_supply
+(
+    tsp_token      varchar(255)    not null,
+    tsp_supply     numeric(52, 20) not null,
+    tsp_created_at timestamp       not null,
+    constraint token_supply_pk
+        primary key (tsp_token, tsp_created_at)
+);
@@ -0,0 +1,3 @@
+-- +migrate Down
+drop table contract_calls;
+drop table contracts;
@@ -0,0 +1,30 @@
+-- +migrate Up
+create table contracts
+(
+    ctr_address         varchar(62)  not null
+        constraint contracts_pk
+            primary key,
+    ctr_deployer        varchar(62)  not null,
+    ctr_owner           varchar(62)  not null,
+    ctr_code_hash       varchar(64)  not null,
+    ctr_deploy_tx_hash  varchar(64)  not null,
+    ctr_upgrade_tx_hash varchar(64)  default '' not null,
+    ctr_upgrades        bigint       default 0 not null,
+    ctr_deployed_at     timestamp    not null,
+    ctr_updated_at      timestamp    not null
+);
+create index contracts_ctr_deployer_index
+    on contracts (ctr_deployer);
+create index contracts_ctr_owner_index
+    on contracts (ctr_owner);
+
+create table contract_calls
+(
+    ccl_contract     varchar(62)  not null,
+    ccl_function     varchar(255) not null,
+    ccl_calls        bigint       not null,
+    ccl_block        bigint       not null,
+    ccl_last_call_at timestamp    not null,
+    constraint contract_calls_pk
+        primary key (ccl_contract, ccl_function)
+);
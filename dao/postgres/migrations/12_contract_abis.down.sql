@@ -0,0 +1,5 @@
+-- +migrate Down
+alter table operations
+    drop column opr_data;
+
+drop table contract_abis;
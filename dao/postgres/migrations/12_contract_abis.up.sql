@@ -0,0 +1,12 @@
+-- +migrate Up
+create table contract_abis
+(
+    cab_address    varchar(62) not null
+        constraint contract_abis_pk
+            primary key,
+    cab_abi        json        not null,
+    cab_created_at timestamp   not null
+);
+
+alter table operations
+    add opr_data text default '' not null;
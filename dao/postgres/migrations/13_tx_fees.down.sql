@@ -0,0 +1,6 @@
+-- +migrate Down
+drop table tx_fees;
+
+delete from daily_stats where length(das_title) > 36;
+alter table daily_stats
+    alter column das_title type varchar(36);
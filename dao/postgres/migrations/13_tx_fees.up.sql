@@ -0,0 +1,18 @@
+-- +migrate Up
+create table tx_fees
+(
+    txf_hash       varchar(64)     not null
+        constraint tx_fees_pk
+            primary key,
+    txf_shard      bigint          not null,
+    txf_contract   varchar(62)     default '' not null,
+    txf_fee        numeric(40, 0)  not null,
+    txf_gas_used   bigint          default 0 not null,
+    txf_gas_limit  bigint          default 0 not null,
+    txf_created_at timestamp       not null
+);
+create index tx_fees_txf_created_at_index
+    on tx_fees (txf_created_at);
+
+alter table daily_stats
+    alter column das_title type varchar(128);
@@ -0,0 +1,15 @@
+-- +migrate Up
+create table shard_throughput
+(
+    stp_shard        bigint    not null,
+    stp_blocks       bigint    default 0 not null,
+    stp_empty_blocks bigint    default 0 not null,
+    stp_txs          bigint    default 0 not null,
+    stp_size         bigint    default 0 not null,
+    stp_block        bigint    default 0 not null,
+    stp_created_at   timestamp not null,
+    constraint shard_throughput_pk
+        primary key (stp_shard, stp_created_at)
+);
+create index shard_throughput_stp_created_at_index
+    on shard_throughput (stp_created_at);
@@ -0,0 +1,16 @@
+-- +migrate Up
+create table validator_epochs
+(
+    vep_key               varchar(192) not null,
+    vep_epoch             bigint       not null,
+    vep_shard             bigint       not null,
+    vep_proposed          bigint       default 0 not null,
+    vep_missed            bigint       default 0 not null,
+    vep_signed            bigint       default 0 not null,
+    vep_missed_signatures bigint       default 0 not null,
+    vep_updated_at        timestamp    not null,
+    constraint validator_epochs_pk
+        primary key (vep_key, vep_epoch)
+);
+create index validator_epochs_vep_epoch_index
+    on validator_epochs (vep_epoch);
@@ -0,0 +1,3 @@
+-- +migrate Down
+drop table epoch_snapshots;
+drop table epochs;
@@ -0,0 +1,26 @@
+-- +migrate Up
+create table epochs
+(
+    epc_epoch       bigint         not null
+        constraint epochs_pk
+            primary key,
+    epc_start_nonce bigint         not null,
+    epc_end_nonce   bigint         not null,
+    epc_start_time  timestamp      not null,
+    epc_end_time    timestamp      not null,
+    epc_txs         bigint         default 0 not null,
+    epc_fees        numeric(40, 0) default 0 not null,
+    epc_rewards     numeric(40, 0) default 0 not null
+);
+
+create table epoch_snapshots
+(
+    eps_epoch      bigint         not null
+        constraint epoch_snapshots_pk
+            primary key,
+    eps_validators bigint         default 0 not null,
+    eps_stake      numeric(40, 0) default 0 not null,
+    eps_top_up     numeric(40, 0) default 0 not null,
+    eps_shards     bigint         default 0 not null,
+    eps_created_at timestamp      not null
+);
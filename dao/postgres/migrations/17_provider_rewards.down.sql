@@ -0,0 +1,2 @@
+-- +migrate Down
+drop table provider_rewards;
@@ -0,0 +1,13 @@
+-- +migrate Up
+create table provider_rewards
+(
+    prw_provider          varchar(62)     not null,
+    prw_epoch             bigint          not null,
+    prw_cumulated_rewards numeric(36, 18) not null,
+    prw_active_stake      numeric(36, 18) not null,
+    prw_updated_at        timestamp       not null,
+    constraint provider_rewards_pk
+        primary key (prw_provider, prw_epoch)
+);
+create index provider_rewards_prw_updated_at_index
+    on provider_rewards (prw_updated_at);
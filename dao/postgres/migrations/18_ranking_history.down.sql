@@ -0,0 +1,3 @@
+-- +migrate Down
+drop index stake_events_ste_validator_index;
+drop table ranking_history;
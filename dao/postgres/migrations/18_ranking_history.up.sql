@@ -0,0 +1,15 @@
+-- +migrate Up
+create table ranking_history
+(
+    rkh_day      date            not null,
+    rkh_provider varchar(62)     not null,
+    rkh_from     numeric(36, 18) not null,
+    rkh_count    bigint          default 0 not null,
+    rkh_amount   numeric(36, 18) default 0 not null,
+    constraint ranking_history_pk
+        primary key (rkh_day, rkh_provider, rkh_from)
+);
+create index ranking_history_rkh_provider_index
+    on ranking_history (rkh_provider);
+create index stake_events_ste_validator_index
+    on stake_events (ste_validator);
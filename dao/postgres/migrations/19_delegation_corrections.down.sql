@@ -0,0 +1,2 @@
+-- +migrate Down
+drop table delegation_corrections;
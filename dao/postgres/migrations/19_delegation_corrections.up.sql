@@ -0,0 +1,14 @@
+-- +migrate Up
+create table delegation_corrections
+(
+    dlc_provider   varchar(62)     not null,
+    dlc_delegator  varchar(62)     not null,
+    dlc_parsed     numeric(36, 18) not null,
+    dlc_on_chain   numeric(36, 18) not null,
+    dlc_drift      numeric(36, 18) not null,
+    dlc_checked_at timestamp       not null,
+    constraint delegation_corrections_pk
+        primary key (dlc_provider, dlc_delegator)
+);
+create index delegation_corrections_dlc_checked_at_index
+    on delegation_corrections (dlc_checked_at);
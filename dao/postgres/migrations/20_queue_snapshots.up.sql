@@ -0,0 +1,12 @@
+-- +migrate Up
+create table queue_snapshots
+(
+    qsn_epoch      bigint       not null,
+    qsn_key        varchar(192) not null,
+    qsn_position   bigint       not null,
+    qsn_updated_at timestamp    not null,
+    constraint queue_snapshots_pk
+        primary key (qsn_epoch, qsn_key)
+);
+create index queue_snapshots_qsn_key_index
+    on queue_snapshots (qsn_key);
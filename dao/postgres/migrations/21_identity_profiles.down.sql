@@ -0,0 +1,2 @@
+-- +migrate Down
+drop table identity_profiles;
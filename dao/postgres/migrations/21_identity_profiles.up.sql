@@ -0,0 +1,14 @@
+-- +migrate Up
+create table identity_profiles
+(
+    idp_identity    varchar(192) not null
+        constraint identity_profiles_pk
+            primary key,
+    idp_name        varchar(255) not null default '',
+    idp_avatar      text         not null default '',
+    idp_description text         not null default '',
+    idp_website     text         not null default '',
+    idp_source      varchar(20)  not null,
+    idp_override    boolean      not null default false,
+    idp_updated_at  timestamp    not null
+);
@@ -0,0 +1,3 @@
+-- +migrate Down
+alter table tokens_sync
+    drop column tks_created_at;
@@ -0,0 +1,3 @@
+-- +migrate Up
+alter table tokens_sync
+    add tks_created_at timestamp;
@@ -0,0 +1,5 @@
+-- +migrate Down
+update nft_metadata
+set nmt_status          = 'failed',
+    nmt_next_attempt_at = nmt_updated_at + interval '100 years'
+where nmt_status = 'abandoned';
@@ -0,0 +1,6 @@
+-- +migrate Up
+update nft_metadata
+set nmt_status          = 'abandoned',
+    nmt_next_attempt_at = nmt_updated_at
+where nmt_status = 'failed'
+  and nmt_attempts >= 5;
@@ -0,0 +1,9 @@
+-- +migrate Down
+alter table validator_epochs
+    drop column vep_total_proposed;
+alter table validator_epochs
+    drop column vep_total_missed;
+alter table validator_epochs
+    drop column vep_total_signed;
+alter table validator_epochs
+    drop column vep_total_missed_signatures;
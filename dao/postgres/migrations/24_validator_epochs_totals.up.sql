@@ -0,0 +1,9 @@
+-- +migrate Up
+alter table validator_epochs
+    add vep_total_proposed bigint;
+alter table validator_epochs
+    add vep_total_missed bigint;
+alter table validator_epochs
+    add vep_total_signed bigint;
+alter table validator_epochs
+    add vep_total_missed_signatures bigint;
@@ -0,0 +1,8 @@
+-- +migrate Down
+drop table blocks;
+drop table miniblocks;
+drop table transactions;
+drop table sc_results;
+drop table accounts;
+drop table esdt_accounts;
+drop table operations;
@@ -0,0 +1,132 @@
+-- +migrate Up
+create table blocks
+(
+    blk_hash             varchar(64)     not null
+        constraint blocks_pk
+            primary key,
+    blk_nonce            bigint          not null,
+    blk_round            bigint          not null,
+    blk_shard            bigint          not null,
+    blk_num_txs          integer         not null,
+    blk_epoch            integer         not null,
+    blk_status           varchar(20)     not null,
+    blk_prev_block_hash  varchar(64)     not null,
+    blk_miniblocks       text[]          not null,
+    blk_accumulated_fees numeric(40, 0)  not null,
+    blk_developer_fees   numeric(40, 0)  not null,
+    blk_created_at       timestamp       not null
+);
+create index blocks_blk_created_at_index
+    on blocks (blk_created_at);
+create index blocks_blk_shard_blk_nonce_index
+    on blocks (blk_shard, blk_nonce);
+
+create table miniblocks
+(
+    mlk_hash                varchar(64) not null
+        constraint miniblocks_pk
+            primary key,
+    mlk_receiver_block_hash varchar(64) not null,
+    mlk_receiver_shard      bigint      not null,
+    mlk_sender_block_hash   varchar(64) not null,
+    mlk_sender_shard        bigint      not null,
+    mlk_type                varchar(50) not null,
+    mlk_created_at          timestamp   not null
+);
+
+create table transactions
+(
+    trn_hash            varchar(64)    not null
+        constraint transactions_pk
+            primary key,
+    trn_status          varchar(20)    not null,
+    mlk_mini_block_hash varchar(64)    not null,
+    trn_value           numeric(40, 0) not null,
+    trn_sender          varchar(62)    not null,
+    trn_sender_shard    bigint         not null,
+    trn_receiver        varchar(62)    not null,
+    trn_receiver_shard  bigint         not null,
+    trn_gas_price       bigint         not null,
+    trn_gas_limit       bigint         not null,
+    trn_gas_used        bigint         not null,
+    trn_fee             numeric(40, 0) not null,
+    trn_nonce           bigint         not null,
+    trn_round           bigint         not null,
+    trn_signature       varchar(128)   not null,
+    trn_data            text           not null,
+    trn_created_at      timestamp      not null
+);
+create index transactions_trn_created_at_index
+    on transactions (trn_created_at);
+create index transactions_trn_sender_index
+    on transactions (trn_sender);
+create index transactions_trn_receiver_index
+    on transactions (trn_receiver);
+create index transactions_mlk_mini_block_hash_index
+    on transactions (mlk_mini_block_hash);
+
+create table sc_results
+(
+    scr_hash       varchar(64)    not null
+        constraint sc_results_pk
+            primary key,
+    trn_hash       varchar(64)    not null,
+    scr_from       varchar(62)    not null,
+    scr_to         varchar(62)    not null,
+    scr_value      numeric(40, 0) not null,
+    scr_data       text           not null,
+    scr_message    text           not null,
+    scr_created_at timestamp      not null
+);
+create index sc_results_trn_hash_index
+    on sc_results (trn_hash);
+
+create table accounts
+(
+    acc_address    varchar(62)    not null
+        constraint accounts_pk
+            primary key,
+    acc_balance    numeric(40, 0) not null,
+    acc_nonce      bigint         not null,
+    acc_created_at timestamp      not null,
+    acc_updated_at timestamp      not null
+);
+create index accounts_acc_balance_index
+    on accounts (acc_balance);
+
+create table esdt_accounts
+(
+    esa_address     varchar(62)    not null,
+    esa_token       varchar(255)   not null,
+    esa_token_nonce bigint         not null,
+    esa_balance     numeric(52, 0) not null,
+    esa_updated_at  timestamp      not null,
+    constraint esdt_accounts_pk
+        primary key (esa_address, esa_token, esa_token_nonce)
+);
+create index esdt_accounts_esa_token_index
+    on esdt_accounts (esa_token);
+
+create table operations
+(
+    opr_hash             varchar(64)   not null
+        constraint operations_pk
+            primary key,
+    opr_original_tx_hash varchar(64)   not null,
+    opr_nonce            bigint        not null,
+    opr_sender           varchar(62)   not null,
+    opr_receiver         varchar(62)   not null,
+    opr_sender_shard     bigint        not null,
+    opr_receiver_shard   bigint        not null,
+    opr_operation        varchar(50)   not null,
+    opr_status           varchar(20)   not null,
+    opr_tokens           text[]        not null,
+    opr_esdt_values      text[]        not null,
+    opr_created_at       timestamp     not null
+);
+create index operations_opr_original_tx_hash_index
+    on operations (opr_original_tx_hash);
+create index operations_opr_created_at_index
+    on operations (opr_created_at);
+create index operations_opr_tokens_index
+    on operations using gin (opr_tokens);
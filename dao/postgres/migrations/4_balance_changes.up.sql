@@ -0,0 +1,13 @@
+-- +migrate Up
+create table balance_changes
+(
+    bch_address    varchar(62)    not null,
+    bch_token      varchar(255)   not null,
+    bch_block      bigint         not null,
+    bch_amount     numeric(52, 0) not null,
+    bch_created_at timestamp      not null,
+    constraint balance_changes_pk
+        primary key (bch_address, bch_token, bch_block)
+);
+create index balance_changes_bch_created_at_index
+    on balance_changes (bch_created_at);
@@ -0,0 +1,2 @@
+-- +migrate Down
+DELETE FROM storage WHERE stg_key = 'distribution';
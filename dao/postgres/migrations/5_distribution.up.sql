@@ -0,0 +1,2 @@
+-- +migrate Up
+INSERT INTO storage (stg_key) VALUES ('distribution');
@@ -0,0 +1,3 @@
+-- +migrate Down
+drop table token_daily_stats;
+drop table token_transfers;
@@ -0,0 +1,24 @@
+-- +migrate Up
+create table token_transfers
+(
+    ttr_token      varchar(255)   not null,
+    ttr_block      bigint         not null,
+    ttr_count      bigint         not null,
+    ttr_volume     numeric(52, 0) not null,
+    ttr_created_at timestamp      not null,
+    constraint token_transfers_pk
+        primary key (ttr_token, ttr_block)
+);
+create index token_transfers_ttr_created_at_index
+    on token_transfers (ttr_created_at);
+
+create table token_daily_stats
+(
+    tds_token      varchar(255)   not null,
+    tds_holders    bigint         not null,
+    tds_transfers  bigint         not null,
+    tds_volume     numeric(52, 0) not null,
+    tds_created_at timestamp      not null,
+    constraint token_daily_stats_pk
+        primary key (tds_token, tds_created_at)
+);
@@ -0,0 +1,20 @@
+-- +migrate Up
+create table nft_metadata
+(
+    nmt_identifier      varchar(255) not null
+        constraint nft_metadata_pk
+            primary key,
+    nmt_status          varchar(20)  not null,
+    nmt_attributes      jsonb,
+    nmt_metadata        jsonb,
+    nmt_metadata_url    text         not null default '',
+    nmt_image_url       text         not null default '',
+    nmt_thumbnail       varchar(255) not null default '',
+    nmt_error           text         not null default '',
+    nmt_attempts        integer      not null default 0,
+    nmt_next_attempt_at timestamp    not null,
+    nmt_created_at      timestamp    not null,
+    nmt_updated_at      timestamp    not null
+);
+create index nft_metadata_nmt_status_nmt_next_attempt_at_index
+    on nft_metadata (nmt_status, nmt_next_attempt_at);
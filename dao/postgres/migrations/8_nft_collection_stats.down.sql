@@ -0,0 +1,8 @@
+-- +migrate Down
+alter table nft_collections
+    drop nfc_items,
+    drop nfc_holders,
+    drop nfc_mints_per_day,
+    drop nfc_transfers_per_day,
+    drop nfc_creators,
+    drop nfc_stats_updated_at;
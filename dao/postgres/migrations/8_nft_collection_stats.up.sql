@@ -0,0 +1,8 @@
+-- +migrate Up
+alter table nft_collections
+    add nfc_items bigint default 0 not null,
+    add nfc_holders bigint default 0 not null,
+    add nfc_mints_per_day bigint default 0 not null,
+    add nfc_transfers_per_day bigint default 0 not null,
+    add nfc_creators json default '[]' not null,
+    add nfc_stats_updated_at timestamp default '1970-01-01' not null;
@@ -0,0 +1,3 @@
+-- +migrate Down
+drop table tokens_sync;
+DELETE FROM storage WHERE stg_key = 'tokens_sync';
@@ -0,0 +1,10 @@
+-- +migrate Up
+create table tokens_sync
+(
+    tks_identifier varchar(255) not null
+        constraint tokens_sync_pk
+            primary key,
+    tks_changed_at timestamp    not null,
+    tks_synced_at  timestamp    not null
+);
+INSERT INTO storage (stg_key) VALUES ('tokens_sync');
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"time"
+)
+
+// CreateNFTMetadata puts NFTs into the resolution queue, already known NFTs are skipped
+func (db Postgres) CreateNFTMetadata(items []dmodels.NFTMetadata) error {
+	if len(items) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.NFTMetadataTable).Columns(
+		"nmt_identifier",
+		"nmt_status",
+		"nmt_next_attempt_at",
+		"nmt_created_at",
+		"nmt_updated_at",
+	)
+	for _, item := range items {
+		if item.Identifier == "" {
+			return fmt.Errorf("field Identifier is empty")
+		}
+		if item.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
+		q = q.Values(
+			item.Identifier,
+			item.Status,
+			item.NextAttemptAt,
+			item.CreatedAt,
+			item.UpdatedAt,
+		)
+	}
+	q = q.Suffix("ON CONFLICT (nmt_identifier) DO NOTHING")
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) UpdateNFTMetadata(item dmodels.NFTMetadata) error {
+	q := squirrel.Update(dmodels.NFTMetadataTable).
+		Where(squirrel.Eq{"nmt_identifier": item.Identifier}).
+		SetMap(map[string]interface{}{
+			"nmt_status":          item.Status,
+			"nmt_attributes":      item.Attributes,
+			"nmt_metadata":        item.Metadata,
+			"nmt_metadata_url":    item.MetadataURL,
+			"nmt_image_url":       item.ImageURL,
+			"nmt_thumbnail":       item.Thumbnail,
+			"nmt_error":           item.Error,
+			"nmt_attempts":        item.Attempts,
+			"nmt_next_attempt_at": item.NextAttemptAt,
+			"nmt_updated_at":      item.UpdatedAt,
+		})
+	return db.update(q)
+}
+
+func (db Postgres) GetNFTMetadata(identifier string) (item dmodels.NFTMetadata, err error) {
+	q := squirrel.Select("*").From(dmodels.NFTMetadataTable).Where(squirrel.Eq{"nmt_identifier": identifier})
+	err = db.first(&item, q)
+	return item, err
+}
+
+// GetNFTMetadataQueue returns pending and failed items which are ready for the next attempt
+func (db Postgres) GetNFTMetadataQueue(limit uint64) (items []dmodels.NFTMetadata, err error) {
+	q := squirrel.Select("*").From(dmodels.NFTMetadataTable).
+		Where(squirrel.Eq{"nmt_status": []string{dmodels.NFTMetadataPending, dmodels.NFTMetadataFailed}}).
+		Where(squirrel.LtOrEq{"nmt_next_attempt_at": time.Now()}).
+		OrderBy("nmt_next_attempt_at").
+		Limit(limit)
+	err = db.find(&items, q)
+	return items, err
+}
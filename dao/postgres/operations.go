@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/ElrondNetwork/elastic-indexer-go/data"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/derrors"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/services/es"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+func (db Postgres) CreateOperations(operations []dmodels.Operation) error {
+	if len(operations) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.OperationsTable).Columns(
+		"opr_hash",
+		"opr_original_tx_hash",
+		"opr_nonce",
+		"opr_sender",
+		"opr_receiver",
+		"opr_sender_shard",
+		"opr_receiver_shard",
+		"opr_operation",
+		"opr_status",
+		"opr_tokens",
+		"opr_esdt_values",
+		"opr_data",
+		"opr_created_at",
+	)
+	for _, op := range operations {
+		if op.Hash == "" {
+			return fmt.Errorf("field Hash is empty")
+		}
+		if op.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
+		q = q.Values(
+			op.Hash,
+			op.OriginalTxHash,
+			op.Nonce,
+			op.Sender,
+			op.Receiver,
+			op.SenderShard,
+			op.ReceiverShard,
+			op.Operation,
+			op.Status,
+			op.Tokens,
+			op.ESDTValues,
+			op.Data,
+			op.CreatedAt,
+		)
+	}
+	q = q.Suffix("ON CONFLICT (opr_hash) DO NOTHING")
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) GetOperations(filter filters.Operations) (operations []es.Operation, err error) {
+	q := squirrel.Select("*").From(dmodels.OperationsTable).OrderBy("opr_created_at desc")
+	q = operationsFilter(q, filter)
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset() != 0 {
+		q = q.Offset(filter.Offset())
+	}
+	var items []dmodels.Operation
+	err = db.find(&items, q)
+	if err != nil {
+		return nil, err
+	}
+	operations = make([]es.Operation, len(items))
+	for i, op := range items {
+		values := make([]decimal.Decimal, len(op.ESDTValues))
+		for j, v := range op.ESDTValues {
+			values[j], _ = decimal.NewFromString(v)
+		}
+		operations[i] = es.Operation{
+			Nonce:          op.Nonce,
+			Sender:         op.Sender,
+			Receiver:       op.Receiver,
+			OriginalTxHash: op.OriginalTxHash,
+			Timestamp:      uint64(op.CreatedAt.Unix()),
+			Status:         op.Status,
+			SenderShard:    op.SenderShard,
+			ReceiverShard:  op.ReceiverShard,
+			Operation:      op.Operation,
+			Tokens:         op.Tokens,
+			ESDTValues:     values,
+			Data:           []byte(op.Data),
+		}
+	}
+	return operations, nil
+}
+
+func (db Postgres) GetOperationsCount(filter filters.Operations) (total uint64, err error) {
+	q := squirrel.Select("count(*) as total").From(dmodels.OperationsTable)
+	q = operationsFilter(q, filter)
+	err = db.first(&total, q)
+	return total, err
+}
+
+// GetOperationsDailyCounts counts the matched operations by the days and the types
+func (db Postgres) GetOperationsDailyCounts(filter filters.Operations) (items []es.OperationsCount, err error) {
+	q := squirrel.Select("date_trunc('day', opr_created_at) as day", "opr_operation", "count(*) as count").
+		From(dmodels.OperationsTable).
+		GroupBy("day", "opr_operation").
+		OrderBy("day", "opr_operation")
+	q = operationsFilter(q, filter)
+	var counts []dmodels.OperationsCount
+	err = db.find(&counts, q)
+	if err != nil {
+		return nil, err
+	}
+	items = make([]es.OperationsCount, len(counts))
+	for i, c := range counts {
+		items[i] = es.OperationsCount{
+			Day:       c.Day,
+			Operation: c.Operation,
+			Count:     c.Count,
+		}
+	}
+	return items, nil
+}
+
+// operationsFilter matches the token either exactly or as the collection of an NFT identifier
+func operationsFilter(q squirrel.SelectBuilder, filter filters.Operations) squirrel.SelectBuilder {
+	if filter.TxHash != "" {
+		q = q.Where(squirrel.Eq{"opr_original_tx_hash": filter.TxHash})
+	}
+	if filter.Token != "" {
+		q = q.Where("exists (select 1 from unnest(opr_tokens) t where t = ? or t like ? || '-%')", filter.Token, filter.Token)
+	}
+	if len(filter.Type) != 0 {
+		q = q.Where(squirrel.Eq{"opr_operation": filter.Type})
+	}
+	if !filter.From.IsZero() {
+		q = q.Where(squirrel.GtOrEq{"opr_created_at": filter.From})
+	}
+	if !filter.To.IsZero() {
+		q = q.Where(squirrel.LtOrEq{"opr_created_at": filter.To})
+	}
+	return q
+}
+
+// GetTokenInfo returns the info of a collection, individual NFTs are not indexed in postgres
+func (db Postgres) GetTokenInfo(id string) (token data.TokenInfo, err error) {
+	if _, nonce := node.SplitTokenIdentifier(id); nonce != 0 {
+		return token, derrors.NotSupported
+	}
+	collection, err := db.GetNFTCollection(id)
+	if err != nil {
+		if err.Error() == NoRowsError {
+			return token, derrors.NotFound
+		}
+		return token, err
+	}
+	return data.TokenInfo{
+		Name:         collection.Name,
+		Identifier:   collection.Identity,
+		Token:        collection.Identity,
+		CurrentOwner: collection.Owner,
+		Type:         collection.Type,
+		Timestamp:    time.Duration(collection.CreatedAt.Unix()),
+	}, nil
+}
+
+// GetNFTTokens isn't supported, the attributes, URIs and royalties of NFTs are not indexed in postgres
+func (db Postgres) GetNFTTokens(filter filters.NFTTokens) (tokens []data.TokenInfo, err error) {
+	return nil, derrors.NotSupported
+}
+
+// GetNFTTokensCount counts the successful creations of NFTs
+func (db Postgres) GetNFTTokensCount(filter filters.NFTTokens) (total uint64, err error) {
+	q := squirrel.Select("count(*) as total").
+		From(dmodels.OperationsTable).
+		Where(squirrel.Eq{
+			"opr_operation": dmodels.ESDTNFTCreateOperation,
+			"opr_status":    dmodels.TxStatusSuccess,
+		})
+	if filter.Collection != "" {
+		q = q.Where("? = any(opr_tokens)", filter.Collection)
+	}
+	err = db.first(&total, q)
+	return total, err
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"time"
+)
+
+// UpdateProviderRewards keeps the last snapshot of the providers within the epoch
+func (db Postgres) UpdateProviderRewards(items []dmodels.ProviderRewards) error {
+	if len(items) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.ProviderRewardsTable).Columns(
+		"prw_provider",
+		"prw_epoch",
+		"prw_cumulated_rewards",
+		"prw_active_stake",
+		"prw_updated_at",
+	)
+	for _, item := range items {
+		if item.Provider == "" {
+			return fmt.Errorf("field Provider is empty")
+		}
+		if item.UpdatedAt.IsZero() {
+			return fmt.Errorf("field UpdatedAt is empty")
+		}
+		q = q.Values(
+			item.Provider,
+			item.Epoch,
+			item.CumulatedRewards,
+			item.ActiveStake,
+			item.UpdatedAt,
+		)
+	}
+	q = q.Suffix(`ON CONFLICT (prw_provider, prw_epoch) DO UPDATE SET
+		prw_cumulated_rewards = excluded.prw_cumulated_rewards,
+		prw_active_stake = excluded.prw_active_stake,
+		prw_updated_at = excluded.prw_updated_at
+		WHERE provider_rewards.prw_updated_at < excluded.prw_updated_at`)
+	_, err := db.insert(q)
+	return err
+}
+
+// GetProviderEpochRewards returns the delta of the cumulated rewards between the snapshots updated since the time,
+// the first snapshot of the provider has no delta and is skipped, the claimed and redelegated rewards of the epoch
+// are used when the delta is negative
+func (db Postgres) GetProviderEpochRewards(from time.Time) (items []dmodels.ProviderEpochRewards, err error) {
+	snapshots := squirrel.Select(
+		"prw_provider",
+		"prw_epoch",
+		"prw_active_stake",
+		"prw_updated_at",
+		"prw_cumulated_rewards - lag(prw_cumulated_rewards) over w as delta",
+		"prw_epoch - lag(prw_epoch) over w as epochs",
+	).From(dmodels.ProviderRewardsTable).
+		Suffix("window w as (partition by prw_provider order by prw_epoch)")
+	claimed := squirrel.Select("ste_validator", "ste_epoch", "sum(ste_amount) as amount").
+		From(dmodels.StakeEventsTable).
+		Where(squirrel.Eq{"ste_type": []string{dmodels.ClaimRewardsEventType, dmodels.ReDelegateRewardsEventType}}).
+		Where(squirrel.GtOrEq{"ste_created_at": from.AddDate(0, 0, -2)}).
+		GroupBy("ste_validator", "ste_epoch")
+	q := squirrel.Select(
+		"s.prw_provider as provider",
+		"s.prw_epoch as epoch",
+		"s.epochs",
+		"(case when s.delta >= 0 then s.delta else coalesce(c.amount, 0) end) as rewards",
+		"s.prw_active_stake as active_stake",
+	).FromSelect(snapshots, "s").
+		JoinClause(claimed.Prefix("left join (").Suffix(") c on c.ste_validator = s.prw_provider and c.ste_epoch = s.prw_epoch")).
+		Where("s.delta is not null").
+		Where(squirrel.GtOrEq{"s.prw_updated_at": from}).
+		Where(squirrel.Gt{"s.prw_active_stake": 0}).
+		OrderBy("s.prw_provider", "s.prw_epoch")
+	err = db.find(&items, q)
+	return items, err
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+)
+
+// UpdateQueueSnapshots keeps the last positions of the keys within the epoch
+func (db Postgres) UpdateQueueSnapshots(items []dmodels.QueueSnapshot) error {
+	if len(items) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.QueueSnapshotsTable).Columns(
+		"qsn_epoch",
+		"qsn_key",
+		"qsn_position",
+		"qsn_updated_at",
+	)
+	for _, item := range items {
+		if item.Key == "" {
+			return fmt.Errorf("field Key is empty")
+		}
+		if item.UpdatedAt.IsZero() {
+			return fmt.Errorf("field UpdatedAt is empty")
+		}
+		q = q.Values(
+			item.Epoch,
+			item.Key,
+			item.Position,
+			item.UpdatedAt,
+		)
+	}
+	q = q.Suffix(`ON CONFLICT (qsn_epoch, qsn_key) DO UPDATE SET
+		qsn_position = excluded.qsn_position,
+		qsn_updated_at = excluded.qsn_updated_at
+		WHERE queue_snapshots.qsn_updated_at < excluded.qsn_updated_at`)
+	_, err := db.insert(q)
+	return err
+}
+
+// GetQueueMovement counts the keys which have left the queue between the snapshots of the last epochs,
+// the epochs without the snapshot of the next epoch are skipped
+func (db Postgres) GetQueueMovement(epochs uint64) (items []dmodels.QueueMovement, err error) {
+	q := squirrel.Select("p.qsn_epoch as epoch", "count(*) filter (where n.qsn_key is null) as left_nodes").
+		From(fmt.Sprintf("%s p", dmodels.QueueSnapshotsTable)).
+		LeftJoin(fmt.Sprintf("%s n on n.qsn_epoch = p.qsn_epoch + 1 and n.qsn_key = p.qsn_key", dmodels.QueueSnapshotsTable)).
+		Where(fmt.Sprintf("exists (select 1 from %s e where e.qsn_epoch = p.qsn_epoch + 1)", dmodels.QueueSnapshotsTable)).
+		Where(fmt.Sprintf("p.qsn_epoch >= (select max(qsn_epoch) from %s) - ?", dmodels.QueueSnapshotsTable), epochs).
+		GroupBy("p.qsn_epoch").
+		OrderBy("p.qsn_epoch")
+	err = db.find(&items, q)
+	return items, err
+}
+
+// GetQueuePositions returns the positions of the key by epochs
+func (db Postgres) GetQueuePositions(key string) (items []dmodels.QueueSnapshot, err error) {
+	q := squirrel.Select("*").
+		From(dmodels.QueueSnapshotsTable).
+		Where(squirrel.Eq{"qsn_key": key}).
+		OrderBy("qsn_epoch")
+	err = db.find(&items, q)
+	return items, err
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+// GetRankingBuckets groups the current delegators of the providers by the lower boundaries of the buckets,
+// the first bucket starts from zero
+func (db Postgres) GetRankingBuckets(boundaries []decimal.Decimal) (items []dmodels.RankingBucket, err error) {
+	thresholds := make([]string, len(boundaries))
+	for i, b := range boundaries {
+		thresholds[i] = b.String()
+	}
+	q := squirrel.Select("validator as rkh_provider").
+		Column(squirrel.Expr("coalesce((?::numeric[])[width_bucket(amount, ?::numeric[])], 0) as rkh_from", pq.Array(thresholds), pq.Array(thresholds))).
+		Columns("count(*) as rkh_count", "sum(amount) as rkh_amount").
+		FromSelect(delegationState(), "s").
+		GroupBy("rkh_provider", "rkh_from").
+		OrderBy("rkh_provider", "rkh_from")
+	err = db.find(&items, q)
+	return items, err
+}
+
+// CreateRankingHistory keeps the last ranking of the providers within the day
+func (db Postgres) CreateRankingHistory(items []dmodels.RankingBucket) error {
+	if len(items) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.RankingHistoryTable).Columns(
+		"rkh_day",
+		"rkh_provider",
+		"rkh_from",
+		"rkh_count",
+		"rkh_amount",
+	)
+	for _, item := range items {
+		if item.Provider == "" {
+			return fmt.Errorf("field Provider is empty")
+		}
+		if item.Day.IsZero() {
+			return fmt.Errorf("field Day is empty")
+		}
+		q = q.Values(
+			item.Day,
+			item.Provider,
+			item.From,
+			item.Count,
+			item.Amount,
+		)
+	}
+	q = q.Suffix(`ON CONFLICT (rkh_day, rkh_provider, rkh_from) DO UPDATE SET
+		rkh_count = excluded.rkh_count,
+		rkh_amount = excluded.rkh_amount`)
+	_, err := db.insert(q)
+	return err
+}
+
+// GetRankingHistory returns the daily buckets of the provider or the sums of all the providers if the provider isn't set
+func (db Postgres) GetRankingHistory(filter filters.RankingHistory) (items []dmodels.RankingBucket, err error) {
+	q := squirrel.Select(
+		"rkh_day",
+		"sum(rkh_count) as rkh_count",
+		"sum(rkh_amount) as rkh_amount",
+		"rkh_from",
+	).From(dmodels.RankingHistoryTable).
+		GroupBy("rkh_day", "rkh_from").
+		OrderBy("rkh_day", "rkh_from")
+	if filter.Provider != "" {
+		q = q.Column("rkh_provider").
+			Where(squirrel.Eq{"rkh_provider": filter.Provider}).
+			GroupBy("rkh_provider")
+	}
+	if !filter.From.IsZero() {
+		q = q.Where(squirrel.GtOrEq{"rkh_day": filter.From.Time})
+	}
+	if !filter.To.IsZero() {
+		q = q.Where(squirrel.LtOrEq{"rkh_day": filter.To.Time})
+	}
+	err = db.find(&items, q)
+	return items, err
+}
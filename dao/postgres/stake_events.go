@@ -43,15 +43,43 @@ func (db Postgres) CreateStakeEvents(events []dmodels.StakeEvent) error {
 }
 
 func (db Postgres) GetDelegationState() (items []dmodels.StakeState, err error) {
-	q := squirrel.Select("ste_validator as validator", "ste_delegator as delegator", "sum(ste_amount) as amount").
+	err = db.find(&items, delegationState())
+	return items, err
+}
+
+// delegationState is the current stake of the delegators in the providers including the reconciliation drifts
+func delegationState() squirrel.SelectBuilder {
+	events := squirrel.Select("ste_validator as validator", "ste_delegator as delegator", "ste_amount as amount").
 		From(dmodels.StakeEventsTable).
 		Where(squirrel.Eq{"ste_type": []string{dmodels.DelegateStakeEventType, dmodels.UnDelegateStakeEventType}}).
-		GroupBy("ste_delegator", "ste_validator").
-		Having("sum(ste_amount) > 0")
+		Suffix(fmt.Sprintf("union all select dlc_provider, dlc_delegator, dlc_drift from %s", dmodels.DelegationCorrectionsTable))
+	return squirrel.Select("validator", "delegator", "sum(amount) as amount").
+		FromSelect(events, "e").
+		GroupBy("delegator", "validator").
+		Having("sum(amount) > 0")
+}
+
+func (db Postgres) GetProviderDelegators(filter filters.ProviderDelegators) (items []dmodels.StakeState, err error) {
+	q := delegationState().
+		Where(squirrel.Eq{"validator": filter.Provider}).
+		OrderBy("amount desc", "delegator")
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset() != 0 {
+		q = q.Offset(filter.Offset())
+	}
 	err = db.find(&items, q)
 	return items, err
 }
 
+func (db Postgres) GetProviderDelegatorsTotal(filter filters.ProviderDelegators) (total uint64, err error) {
+	q := squirrel.Select("count(*)").
+		FromSelect(delegationState().Where(squirrel.Eq{"validator": filter.Provider}), "s")
+	err = db.first(&total, q)
+	return total, err
+}
+
 func (db Postgres) GetStakeState() (items []dmodels.StakeState, err error) {
 	q := squirrel.Select("ste_validator as validator", "ste_delegator as delegator", "sum(ste_amount) as amount").
 		From(dmodels.StakeEventsTable).
@@ -94,3 +122,138 @@ func (db Postgres) GetStakeEventsTotal(filter filters.StakeEvents) (total uint64
 	err = db.first(&total, q)
 	return total, err
 }
+
+// GetStakeFlows aggregates the delegation events by days. A delegator is new on the first delegation
+// and leaving when the undelegation takes the whole stake, the stake is counted within the provider
+// or across all the providers if the provider isn't set
+func (db Postgres) GetStakeFlows(filter filters.StakeFlows) (items []dmodels.StakeFlow, err error) {
+	partition := "ste_delegator"
+	if filter.Provider != "" {
+		partition = "ste_validator, ste_delegator"
+	}
+	events := squirrel.Select(
+		"ste_type",
+		"ste_delegator",
+		"ste_amount",
+		"ste_created_at",
+		fmt.Sprintf("min(ste_created_at) filter (where ste_type = '%s') over (partition by %s) as first_delegation",
+			dmodels.DelegateStakeEventType, partition),
+		fmt.Sprintf("sum(ste_amount) filter (where ste_type in ('%s', '%s', '%s')) over (partition by %s order by ste_created_at, ste_tx_hash) as stake",
+			dmodels.DelegateStakeEventType, dmodels.UnDelegateStakeEventType, dmodels.ReDelegateRewardsEventType, partition),
+	).From(dmodels.StakeEventsTable).
+		Where(squirrel.Eq{"ste_type": []string{
+			dmodels.DelegateStakeEventType,
+			dmodels.UnDelegateStakeEventType,
+			dmodels.ReDelegateRewardsEventType,
+			dmodels.WithdrawEventType,
+		}})
+	if filter.Provider != "" {
+		events = events.Where(squirrel.Eq{"ste_validator": filter.Provider})
+	}
+	sumByType := func(eventType string, column string) string {
+		return fmt.Sprintf("coalesce(abs(sum(ste_amount) filter (where ste_type = '%s')), 0) as %s", eventType, column)
+	}
+	q := squirrel.Select(
+		"date_trunc('day', ste_created_at) as day",
+		sumByType(dmodels.DelegateStakeEventType, "inflow"),
+		sumByType(dmodels.UnDelegateStakeEventType, "outflow"),
+		sumByType(dmodels.ReDelegateRewardsEventType, "redelegated"),
+		sumByType(dmodels.WithdrawEventType, "withdrawn"),
+		fmt.Sprintf("count(distinct ste_delegator) filter (where ste_type = '%s' and ste_created_at = first_delegation) as new_delegators",
+			dmodels.DelegateStakeEventType),
+		fmt.Sprintf("count(distinct ste_delegator) filter (where ste_type = '%s' and stake <= 0 and stake - ste_amount > 0) as leaving_delegators",
+			dmodels.UnDelegateStakeEventType),
+	).FromSelect(events, "e").
+		Where(squirrel.GtOrEq{"ste_created_at": filter.From.Time}).
+		Where(squirrel.Lt{"ste_created_at": filter.To.Time}).
+		GroupBy("day").
+		OrderBy("day")
+	err = db.find(&items, q)
+	return items, err
+}
+
+// GetUnbondingEvents returns the undelegations, the unstakes and the withdrawals in the order of creation
+func (db Postgres) GetUnbondingEvents(filter filters.UnbondingEvents) (items []dmodels.StakeEvent, err error) {
+	q := squirrel.Select("*").
+		From(dmodels.StakeEventsTable).
+		Where(squirrel.Eq{"ste_type": []string{
+			dmodels.UnDelegateStakeEventType,
+			dmodels.UnStakeEventType,
+			dmodels.WithdrawEventType,
+			dmodels.UnBondEventType,
+		}}).
+		OrderBy("ste_created_at")
+	if filter.Delegator != "" {
+		q = q.Where(squirrel.Eq{"ste_delegator": filter.Delegator})
+	}
+	if filter.FromEpoch != 0 {
+		q = q.Where(squirrel.GtOrEq{"ste_epoch": filter.FromEpoch})
+	}
+	err = db.find(&items, q)
+	return items, err
+}
+
+// GetUnbondingAmounts sums the undelegated and unstaked amounts by the validators and the epochs
+func (db Postgres) GetUnbondingAmounts(fromEpoch uint64) (items []dmodels.UnbondingAmount, err error) {
+	q := squirrel.Select("ste_validator as validator", "ste_epoch as epoch", "abs(sum(ste_amount)) as amount").
+		From(dmodels.StakeEventsTable).
+		Where(squirrel.Eq{"ste_type": []string{dmodels.UnDelegateStakeEventType, dmodels.UnStakeEventType}}).
+		Where(squirrel.GtOrEq{"ste_epoch": fromEpoch}).
+		GroupBy("ste_validator", "ste_epoch")
+	err = db.find(&items, q)
+	return items, err
+}
+
+// stakeSources splits the stake events by the sources: delegations to the staking providers,
+// the legacy delegation contract and the direct stake sent to the staking and auction contracts
+func stakeSources(filter filters.StakeSources) squirrel.SelectBuilder {
+	q := squirrel.Select("ste_created_at", "ste_amount").
+		Column(squirrel.Expr(fmt.Sprintf("(case when ste_type in ('%s', '%s', '%s') then '%s' when ste_validator = ? then '%s' else '%s' end) as source",
+			dmodels.DelegateStakeEventType, dmodels.UnDelegateStakeEventType, dmodels.ReDelegateRewardsEventType, dmodels.ProvidersStakeSource,
+			dmodels.LegacyStakeSource, dmodels.DirectStakeSource), filter.Legacy)).
+		From(dmodels.StakeEventsTable).
+		Where(squirrel.Or{
+			squirrel.Eq{"ste_type": []string{
+				dmodels.DelegateStakeEventType,
+				dmodels.UnDelegateStakeEventType,
+				dmodels.ReDelegateRewardsEventType,
+			}},
+			squirrel.And{
+				squirrel.Eq{"ste_type": []string{
+					dmodels.StakeStakeEventType,
+					dmodels.UnStakeEventType,
+					dmodels.ReStakeRewardsEventType,
+				}},
+				squirrel.Eq{"ste_validator": append([]string{filter.Legacy}, filter.Direct...)},
+			},
+		})
+	if filter.Delegator != "" {
+		q = q.Where(squirrel.Eq{"ste_delegator": filter.Delegator})
+	}
+	if !filter.To.IsZero() {
+		q = q.Where(squirrel.Lt{"ste_created_at": filter.To.Time})
+	}
+	return q
+}
+
+// GetStakeSources returns the current stake of the sources
+func (db Postgres) GetStakeSources(filter filters.StakeSources) (items []dmodels.SourceStake, err error) {
+	q := squirrel.Select("source", "sum(ste_amount) as amount").
+		FromSelect(stakeSources(filter), "s").
+		GroupBy("source")
+	err = db.find(&items, q)
+	return items, err
+}
+
+// GetStakeSourcesHistory returns the stake of the sources at the end of the days with the events
+func (db Postgres) GetStakeSourcesHistory(filter filters.StakeSources) (items []dmodels.SourceStake, err error) {
+	q := squirrel.Select(
+		"date_trunc('day', ste_created_at) as day",
+		"source",
+		"sum(sum(ste_amount)) over (partition by source order by date_trunc('day', ste_created_at)) as amount",
+	).FromSelect(stakeSources(filter), "s").
+		GroupBy("day", "source").
+		OrderBy("day", "source")
+	err = db.find(&items, q)
+	return items, err
+}
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+)
+
+// CreateShardThroughput adds blocks to the minute counters of the shard, the last applied hyperblock is kept per minute
+func (db Postgres) CreateShardThroughput(items []dmodels.ShardThroughput) error {
+	if len(items) == 0 {
+		return nil
+	}
+	rows := make([][]interface{}, len(items))
+	for i, item := range items {
+		if item.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
+		rows[i] = []interface{}{item.Shard, item.Blocks, item.EmptyBlocks, item.Txs, item.Size, item.Block, item.CreatedAt}
+	}
+	q := squirrel.Insert(dmodels.ShardThroughputTable).
+		PrefixExpr(withValues("v",
+			[]string{"shard", "blocks", "empty_blocks", "txs", "size", "block", "created_at"},
+			[]string{"bigint", "bigint", "bigint", "bigint", "bigint", "bigint", "timestamp"},
+			rows,
+		)).
+		Columns(
+			"stp_shard",
+			"stp_blocks",
+			"stp_empty_blocks",
+			"stp_txs",
+			"stp_size",
+			"stp_block",
+			"stp_created_at",
+		).
+		Select(squirrel.Select("v.shard", "sum(v.blocks)", "sum(v.empty_blocks)", "sum(v.txs)", "sum(v.size)", "max(v.block)", "v.created_at").
+			From("v").
+			LeftJoin(fmt.Sprintf("%s t on t.stp_shard = v.shard and t.stp_created_at = v.created_at", dmodels.ShardThroughputTable)).
+			Where("v.block > coalesce(t.stp_block, -1)").
+			GroupBy("v.shard", "v.created_at")).
+		Suffix(`ON CONFLICT (stp_shard, stp_created_at) DO UPDATE SET
+		stp_blocks = shard_throughput.stp_blocks + excluded.stp_blocks,
+		stp_empty_blocks = shard_throughput.stp_empty_blocks + excluded.stp_empty_blocks,
+		stp_txs = shard_throughput.stp_txs + excluded.stp_txs,
+		stp_size = shard_throughput.stp_size + excluded.stp_size,
+		stp_block = excluded.stp_block`)
+	_, err := db.insert(q)
+	return err
+}
+
+// networkThroughputQuery sums the shards (all by default) within every minute
+func networkThroughputQuery(filter filters.Throughput) squirrel.SelectBuilder {
+	q := squirrel.Select(
+		"sum(stp_blocks) as stp_blocks",
+		"sum(stp_empty_blocks) as stp_empty_blocks",
+		"sum(stp_txs) as stp_txs",
+		"sum(stp_size) as stp_size",
+		"max(stp_block) as stp_block",
+		"stp_created_at",
+	).From(dmodels.ShardThroughputTable).GroupBy("stp_created_at")
+	if len(filter.Shard) != 0 {
+		q = q.Where(squirrel.Eq{"stp_shard": filter.Shard})
+	}
+	if !filter.From.IsZero() {
+		q = q.Where(squirrel.GtOrEq{"stp_created_at": filter.From})
+	}
+	if !filter.To.IsZero() {
+		q = q.Where(squirrel.LtOrEq{"stp_created_at": filter.To})
+	}
+	return q
+}
+
+func (db Postgres) GetThroughputRange(filter filters.Throughput) (items []dmodels.ShardThroughput, err error) {
+	q := networkThroughputQuery(filter).OrderBy("stp_created_at")
+	err = db.find(&items, q)
+	return items, err
+}
+
+// GetLatestThroughput returns the last parsed minutes, the latest one goes first
+func (db Postgres) GetLatestThroughput(limit uint64) (items []dmodels.ShardThroughput, err error) {
+	q := networkThroughputQuery(filters.Throughput{}).OrderBy("stp_created_at desc").Limit(limit)
+	err = db.find(&items, q)
+	return items, err
+}
+
+// GetPeakThroughput returns the minute with the highest number of transactions
+func (db Postgres) GetPeakThroughput(filter filters.Throughput) (item dmodels.ShardThroughput, err error) {
+	q := networkThroughputQuery(filter).OrderBy("sum(stp_txs) desc", "stp_created_at desc").Limit(1)
+	err = db.first(&item, q)
+	return item, err
+}
+
+// GetShardsThroughput sums the minutes of every shard within the range
+func (db Postgres) GetShardsThroughput(filter filters.Throughput) (items []dmodels.ShardThroughput, err error) {
+	q := squirrel.Select(
+		"stp_shard",
+		"sum(stp_blocks) as stp_blocks",
+		"sum(stp_empty_blocks) as stp_empty_blocks",
+		"sum(stp_txs) as stp_txs",
+		"sum(stp_size) as stp_size",
+		"max(stp_block) as stp_block",
+		"max(stp_created_at) as stp_created_at",
+	).From(dmodels.ShardThroughputTable).GroupBy("stp_shard").OrderBy("stp_shard")
+	if len(filter.Shard) != 0 {
+		q = q.Where(squirrel.Eq{"stp_shard": filter.Shard})
+	}
+	if !filter.From.IsZero() {
+		q = q.Where(squirrel.GtOrEq{"stp_created_at": filter.From})
+	}
+	if !filter.To.IsZero() {
+		q = q.Where(squirrel.LtOrEq{"stp_created_at": filter.To})
+	}
+	err = db.find(&items, q)
+	return items, err
+}
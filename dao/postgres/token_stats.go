@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"time"
+)
+
+func (db Postgres) CreateTokenTransfers(transfers []dmodels.TokenTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.TokenTransfersTable).Columns(
+		"ttr_token",
+		"ttr_block",
+		"ttr_count",
+		"ttr_volume",
+		"ttr_created_at",
+	)
+	for _, t := range transfers {
+		if t.Token == "" {
+			return fmt.Errorf("field Token is empty")
+		}
+		if t.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
+		q = q.Values(
+			t.Token,
+			t.Block,
+			t.Count,
+			t.Volume,
+			t.CreatedAt,
+		)
+	}
+	q = q.Suffix("ON CONFLICT (ttr_token, ttr_block) DO NOTHING")
+	_, err := db.insert(q)
+	return err
+}
+
+// GetTokenTransfersSums returns transfers count and volume of every token within [from, to)
+func (db Postgres) GetTokenTransfersSums(from time.Time, to time.Time) (items []dmodels.TokenTransfersSum, err error) {
+	q := squirrel.Select("ttr_token as token", "sum(ttr_count) as count", "sum(ttr_volume) as volume").
+		From(dmodels.TokenTransfersTable).
+		Where(squirrel.GtOrEq{"ttr_created_at": from}).
+		Where(squirrel.Lt{"ttr_created_at": to}).
+		GroupBy("ttr_token")
+	err = db.find(&items, q)
+	return items, err
+}
+
+func (db Postgres) CreateTokenDailyStats(stats []dmodels.TokenDailyStat) error {
+	if len(stats) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.TokenDailyStatsTable).Columns(
+		"tds_token",
+		"tds_holders",
+		"tds_transfers",
+		"tds_volume",
+		"tds_created_at",
+	)
+	for _, s := range stats {
+		if s.Token == "" {
+			return fmt.Errorf("field Token is empty")
+		}
+		if s.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
+		q = q.Values(
+			s.Token,
+			s.Holders,
+			s.Transfers,
+			s.Volume,
+			s.CreatedAt,
+		)
+	}
+	q = q.Suffix("ON CONFLICT (tds_token, tds_created_at) DO NOTHING")
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) GetTokenDailyStatsRange(filter filters.TokenDailyStats) (items []dmodels.TokenDailyStat, err error) {
+	q := squirrel.Select("*").
+		From(dmodels.TokenDailyStatsTable).
+		OrderBy("tds_created_at").
+		Where(squirrel.Eq{"tds_token": filter.Token})
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if !filter.From.IsZero() {
+		q = q.Where(squirrel.GtOrEq{"tds_created_at": filter.From})
+	}
+	if !filter.To.IsZero() {
+		q = q.Where(squirrel.LtOrEq{"tds_created_at": filter.To})
+	}
+	err = db.find(&items, q)
+	return items, err
+}
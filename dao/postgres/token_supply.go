@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+)
+
+// UpdateTokenSupply keeps the last supply of the token within the day
+func (db Postgres) UpdateTokenSupply(supply dmodels.TokenSupply) error {
+	if supply.Token == "" {
+		return fmt.Errorf("field Token is empty")
+	}
+	if supply.CreatedAt.IsZero() {
+		return fmt.Errorf("field CreatedAt is empty")
+	}
+	q := squirrel.Insert(dmodels.TokenSupplyTable).Columns(
+		"tsp_token",
+		"tsp_supply",
+		"tsp_created_at",
+	).Values(
+		supply.Token,
+		supply.Supply,
+		supply.CreatedAt,
+	).Suffix("ON CONFLICT (tsp_token, tsp_created_at) DO UPDATE SET tsp_supply = excluded.tsp_supply")
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) GetTokenSupplyRange(filter filters.TokenDailyStats) (items []dmodels.TokenSupply, err error) {
+	q := squirrel.Select("*").
+		From(dmodels.TokenSupplyTable).
+		OrderBy("tsp_created_at").
+		Where(squirrel.Eq{"tsp_token": filter.Token})
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if !filter.From.IsZero() {
+		q = q.Where(squirrel.GtOrEq{"tsp_created_at": filter.From})
+	}
+	if !filter.To.IsZero() {
+		q = q.Where(squirrel.LtOrEq{"tsp_created_at": filter.To})
+	}
+	err = db.find(&items, q)
+	return items, err
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"fmt"
 	"github.com/Masterminds/squirrel"
 	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
 	"github.com/everstake/elrond-monitor-backend/dao/filters"
@@ -95,8 +96,33 @@ func (db *Postgres) UpdateNFTCollection(collection dmodels.NFTCollection) error
 	return db.update(q)
 }
 
+func (db Postgres) UpdateNFTCollectionStats(identity string, stats dmodels.NFTCollectionStats) error {
+	q := squirrel.Update(dmodels.NFTCollectionsTable).
+		Where(squirrel.Eq{"nfc_identity": identity}).
+		SetMap(map[string]interface{}{
+			"nfc_items":             stats.Items,
+			"nfc_holders":           stats.Holders,
+			"nfc_mints_per_day":     stats.MintsPerDay,
+			"nfc_transfers_per_day": stats.TransfersPerDay,
+			"nfc_creators":          stats.Creators,
+			"nfc_stats_updated_at":  stats.StatsUpdatedAt,
+		})
+	return db.update(q)
+}
+
 func (db Postgres) GetNFTCollections(filter filters.NFTCollections) (collections []dmodels.NFTCollection, err error) {
-	q := squirrel.Select("*").From(dmodels.NFTCollectionsTable).OrderBy("nfc_created_at desc")
+	orderBy := "nfc_created_at"
+	switch filter.Sort {
+	case filters.NFTCollectionsSortByItems:
+		orderBy = "nfc_items"
+	case filters.NFTCollectionsSortByHolders:
+		orderBy = "nfc_holders"
+	case filters.NFTCollectionsSortByMints:
+		orderBy = "nfc_mints_per_day"
+	case filters.NFTCollectionsSortByTransfers:
+		orderBy = "nfc_transfers_per_day"
+	}
+	q := squirrel.Select("*").From(dmodels.NFTCollectionsTable).OrderBy(fmt.Sprintf("%s desc", orderBy), "nfc_identity")
 	if filter.Limit != 0 {
 		q = q.Limit(filter.Limit)
 	}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"time"
+)
+
+// MarkTokensChanged sets the time of the last seen change, tokens unknown before are added as never synced
+func (db Postgres) MarkTokensChanged(identifiers []string, changedAt time.Time) error {
+	if len(identifiers) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.TokensSyncTable).Columns(
+		"tks_identifier",
+		"tks_changed_at",
+		"tks_synced_at",
+	)
+	for _, identifier := range identifiers {
+		if identifier == "" {
+			return fmt.Errorf("identifier is empty")
+		}
+		q = q.Values(identifier, changedAt, time.Unix(0, 0))
+	}
+	q = q.Suffix(`ON CONFLICT (tks_identifier) DO UPDATE SET
+		tks_changed_at = greatest(tokens_sync.tks_changed_at, excluded.tks_changed_at)`)
+	_, err := db.insert(q)
+	return err
+}
+
+// MarkTokensIssued sets the time of the issue tx, the time seen first is kept
+func (db Postgres) MarkTokensIssued(items []dmodels.TokenSync) error {
+	if len(items) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.TokensSyncTable).Columns(
+		"tks_identifier",
+		"tks_changed_at",
+		"tks_synced_at",
+		"tks_created_at",
+	)
+	for _, item := range items {
+		if item.Identifier == "" {
+			return fmt.Errorf("identifier is empty")
+		}
+		if item.CreatedAt == nil {
+			return fmt.Errorf("created_at is empty")
+		}
+		q = q.Values(item.Identifier, *item.CreatedAt, time.Unix(0, 0), *item.CreatedAt)
+	}
+	q = q.Suffix(`ON CONFLICT (tks_identifier) DO UPDATE SET
+		tks_changed_at = greatest(tokens_sync.tks_changed_at, excluded.tks_changed_at),
+		tks_created_at = coalesce(tokens_sync.tks_created_at, excluded.tks_created_at)`)
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) UpdateTokenSynced(identifier string, syncedAt time.Time) error {
+	q := squirrel.Insert(dmodels.TokensSyncTable).Columns(
+		"tks_identifier",
+		"tks_changed_at",
+		"tks_synced_at",
+	).Values(identifier, time.Unix(0, 0), syncedAt).
+		Suffix("ON CONFLICT (tks_identifier) DO UPDATE SET tks_synced_at = excluded.tks_synced_at")
+	_, err := db.insert(q)
+	return err
+}
+
+func (db Postgres) GetTokenSync(identifier string) (item dmodels.TokenSync, err error) {
+	q := squirrel.Select("*").From(dmodels.TokensSyncTable).Where(squirrel.Eq{"tks_identifier": identifier})
+	err = db.first(&item, q)
+	return item, err
+}
+
+func (db Postgres) GetTokensSync() (items []dmodels.TokenSync, err error) {
+	q := squirrel.Select("*").From(dmodels.TokensSyncTable)
+	err = db.find(&items, q)
+	return items, err
+}
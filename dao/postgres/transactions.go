@@ -2,9 +2,13 @@ package postgres
 
 import (
 	"fmt"
+	"github.com/ElrondNetwork/elastic-indexer-go/data"
 	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/derrors"
 	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
 	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/services/es"
+	"time"
 )
 
 func (db Postgres) CreateTransactions(transactions []dmodels.Transaction) error {
@@ -21,7 +25,12 @@ func (db Postgres) CreateTransactions(transactions []dmodels.Transaction) error
 		"trn_receiver",
 		"trn_receiver_shard",
 		"trn_gas_price",
+		"trn_gas_limit",
+		"trn_gas_used",
+		"trn_fee",
 		"trn_nonce",
+		"trn_round",
+		"trn_signature",
 		"trn_data",
 		"trn_created_at",
 	)
@@ -42,7 +51,12 @@ func (db Postgres) CreateTransactions(transactions []dmodels.Transaction) error
 			tx.Receiver,
 			tx.ReceiverShard,
 			tx.GasPrice,
+			tx.GasLimit,
+			tx.GasUsed,
+			tx.Fee,
 			tx.Nonce,
+			tx.Round,
+			tx.Signature,
 			tx.Data,
 			tx.CreatedAt,
 		)
@@ -64,19 +78,24 @@ func (db Postgres) CreateSCResults(results []dmodels.SCResult) error {
 		"scr_value",
 		"scr_data",
 		"scr_message",
+		"scr_created_at",
 	)
 	for _, r := range results {
 		if r.TxHash == "" {
 			return fmt.Errorf("field TxHash is empty")
 		}
+		if r.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
 		q = q.Values(
 			r.Hash,
 			r.TxHash,
-			r.To,
 			r.From,
+			r.To,
 			r.Value,
 			r.Data,
 			r.Message,
+			r.CreatedAt,
 		)
 	}
 	q = q.Suffix("ON CONFLICT (scr_hash) DO NOTHING")
@@ -84,7 +103,7 @@ func (db Postgres) CreateSCResults(results []dmodels.SCResult) error {
 	return err
 }
 
-func (db Postgres) GetTransactions(filter filters.Transactions) (txs []dmodels.Transaction, err error) {
+func (db Postgres) GetTransactions(filter filters.Transactions) (txs []data.Transaction, err error) {
 	q := squirrel.Select("*").From(dmodels.TransactionsTable).OrderBy("trn_created_at desc")
 	if filter.Address != "" {
 		q = q.Where(squirrel.Or{squirrel.Eq{"trn_sender": filter.Address}, squirrel.Eq{"trn_receiver": filter.Address}})
@@ -98,11 +117,19 @@ func (db Postgres) GetTransactions(filter filters.Transactions) (txs []dmodels.T
 	if filter.Offset() != 0 {
 		q = q.Offset(filter.Offset())
 	}
-	err = db.find(&txs, q)
-	return txs, err
+	var items []dmodels.Transaction
+	err = db.find(&items, q)
+	if err != nil {
+		return nil, err
+	}
+	txs = make([]data.Transaction, len(items))
+	for i, tx := range items {
+		txs[i] = toDataTransaction(tx)
+	}
+	return txs, nil
 }
 
-func (db Postgres) GetTransactionsTotal(filter filters.Transactions) (total uint64, err error) {
+func (db Postgres) GetTransactionsCount(filter filters.Transactions) (total uint64, err error) {
 	q := squirrel.Select("count(*) as total").From(dmodels.TransactionsTable)
 	if filter.Address != "" {
 		q = q.Where(squirrel.Or{squirrel.Eq{"trn_sender": filter.Address}, squirrel.Eq{"trn_receiver": filter.Address}})
@@ -114,14 +141,63 @@ func (db Postgres) GetTransactionsTotal(filter filters.Transactions) (total uint
 	return total, err
 }
 
-func (db Postgres) GetTransaction(hash string) (tx dmodels.Transaction, err error) {
+func (db Postgres) GetTransaction(hash string) (tx es.Tx, err error) {
 	q := squirrel.Select("*").From(dmodels.TransactionsTable).Where(squirrel.Eq{"trn_hash": hash})
-	err = db.first(&tx, q)
-	return tx, err
+	var item dmodels.Transaction
+	err = db.first(&item, q)
+	if err != nil {
+		if err.Error() == NoRowsError {
+			return tx, derrors.NotFound
+		}
+		return tx, err
+	}
+	return es.Tx{Transaction: toDataTransaction(item)}, nil
 }
 
-func (db Postgres) GetSCResults(txHash string) (results []dmodels.SCResult, err error) {
+func (db Postgres) GetSCResults(txHash string) (results []es.SCResult, err error) {
 	q := squirrel.Select("*").From(dmodels.SCResultsTable).Where(squirrel.Eq{"trn_hash": txHash})
-	err = db.find(&results, q)
-	return results, err
+	var items []dmodels.SCResult
+	err = db.find(&items, q)
+	if err != nil {
+		return nil, err
+	}
+	results = make([]es.SCResult, len(items))
+	for i, r := range items {
+		results[i] = es.SCResult{
+			ScResult: data.ScResult{
+				Hash:           r.Hash,
+				Value:          r.Value.String(),
+				Sender:         r.From,
+				Receiver:       r.To,
+				Data:           []byte(r.Data),
+				OriginalTxHash: r.TxHash,
+				ReturnMessage:  r.Message,
+				Timestamp:      time.Duration(r.CreatedAt.Unix()),
+			},
+			ResultHash: r.Hash,
+		}
+	}
+	return results, nil
+}
+
+func toDataTransaction(tx dmodels.Transaction) data.Transaction {
+	return data.Transaction{
+		Hash:          tx.Hash,
+		MBHash:        tx.MiniBlockHash,
+		Nonce:         tx.Nonce,
+		Round:         tx.Round,
+		Value:         tx.Value.String(),
+		Receiver:      tx.Receiver,
+		Sender:        tx.Sender,
+		ReceiverShard: uint32(tx.ReceiverShard),
+		SenderShard:   uint32(tx.SenderShard),
+		GasPrice:      tx.GasPrice,
+		GasLimit:      tx.GasLimit,
+		GasUsed:       tx.GasUsed,
+		Fee:           tx.Fee.String(),
+		Data:          []byte(tx.Data),
+		Signature:     tx.Signature,
+		Timestamp:     time.Duration(tx.CreatedAt.Unix()),
+		Status:        tx.Status,
+	}
 }
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"time"
+)
+
+func (db Postgres) CreateTxFees(fees []dmodels.TxFee) error {
+	if len(fees) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.TxFeesTable).Columns(
+		"txf_hash",
+		"txf_shard",
+		"txf_contract",
+		"txf_fee",
+		"txf_gas_used",
+		"txf_gas_limit",
+		"txf_created_at",
+	)
+	for _, f := range fees {
+		if f.Hash == "" {
+			return fmt.Errorf("field Hash is empty")
+		}
+		if f.CreatedAt.IsZero() {
+			return fmt.Errorf("field CreatedAt is empty")
+		}
+		q = q.Values(
+			f.Hash,
+			f.Shard,
+			f.Contract,
+			f.Fee,
+			f.GasUsed,
+			f.GasLimit,
+			f.CreatedAt,
+		)
+	}
+	q = q.Suffix("ON CONFLICT (txf_hash) DO NOTHING")
+	_, err := db.insert(q)
+	return err
+}
+
+// GetFeeStats returns total, median and 90th percentile of the fees and the used gas within [from, to)
+func (db Postgres) GetFeeStats(from time.Time, to time.Time) (stats dmodels.FeeStats, err error) {
+	q := squirrel.Select(
+		"coalesce(sum(txf_fee), 0) as total",
+		"count(*) as count",
+		"coalesce(percentile_cont(0.5) within group (order by txf_fee), 0) as median",
+		"coalesce(percentile_cont(0.9) within group (order by txf_fee), 0) as p90",
+		"coalesce(sum(txf_gas_used), 0) as gas_used",
+		"coalesce(sum(txf_gas_limit), 0) as gas_limit",
+	).
+		From(dmodels.TxFeesTable).
+		Where(squirrel.GtOrEq{"txf_created_at": from}).
+		Where(squirrel.Lt{"txf_created_at": to})
+	err = db.first(&stats, q)
+	return stats, err
+}
+
+// GetShardFees returns fees paid by the senders of every shard within [from, to)
+func (db Postgres) GetShardFees(from time.Time, to time.Time) (items []dmodels.ShardFees, err error) {
+	q := squirrel.Select("txf_shard", "count(*) as count", "sum(txf_fee) as total").
+		From(dmodels.TxFeesTable).
+		Where(squirrel.GtOrEq{"txf_created_at": from}).
+		Where(squirrel.Lt{"txf_created_at": to}).
+		GroupBy("txf_shard")
+	err = db.find(&items, q)
+	return items, err
+}
+
+// GetTopFeeContracts returns the smart contracts with the highest fees paid for their calls within [from, to)
+func (db Postgres) GetTopFeeContracts(from time.Time, to time.Time, limit uint64) (items []dmodels.ContractFees, err error) {
+	q := squirrel.Select("txf_contract", "count(*) as count", "sum(txf_fee) as total").
+		From(dmodels.TxFeesTable).
+		Where(squirrel.NotEq{"txf_contract": ""}).
+		Where(squirrel.GtOrEq{"txf_created_at": from}).
+		Where(squirrel.Lt{"txf_created_at": to}).
+		GroupBy("txf_contract").
+		OrderBy("total desc").
+		Limit(limit)
+	err = db.find(&items, q)
+	return items, err
+}
+
+func (db Postgres) DeleteTxFees(before time.Time) error {
+	q := squirrel.Delete(dmodels.TxFeesTable).Where(squirrel.Lt{"txf_created_at": before})
+	return db.delete(q)
+}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+)
+
+// UpdateValidatorEpochs keeps the last snapshot of the counters within the epoch
+func (db Postgres) UpdateValidatorEpochs(items []dmodels.ValidatorEpoch) error {
+	if len(items) == 0 {
+		return nil
+	}
+	q := squirrel.Insert(dmodels.ValidatorEpochsTable).Columns(
+		"vep_key",
+		"vep_epoch",
+		"vep_shard",
+		"vep_proposed",
+		"vep_missed",
+		"vep_signed",
+		"vep_missed_signatures",
+		"vep_total_proposed",
+		"vep_total_missed",
+		"vep_total_signed",
+		"vep_total_missed_signatures",
+		"vep_updated_at",
+	)
+	for _, item := range items {
+		if item.Key == "" {
+			return fmt.Errorf("field Key is empty")
+		}
+		if item.UpdatedAt.IsZero() {
+			return fmt.Errorf("field UpdatedAt is empty")
+		}
+		q = q.Values(
+			item.Key,
+			item.Epoch,
+			item.Shard,
+			item.Proposed,
+			item.Missed,
+			item.Signed,
+			item.MissedSignatures,
+			item.TotalProposed,
+			item.TotalMissed,
+			item.TotalSigned,
+			item.TotalMissedSignatures,
+			item.UpdatedAt,
+		)
+	}
+	q = q.Suffix(`ON CONFLICT (vep_key, vep_epoch) DO UPDATE SET
+		vep_shard = excluded.vep_shard,
+		vep_proposed = excluded.vep_proposed,
+		vep_missed = excluded.vep_missed,
+		vep_signed = excluded.vep_signed,
+		vep_missed_signatures = excluded.vep_missed_signatures,
+		vep_total_proposed = excluded.vep_total_proposed,
+		vep_total_missed = excluded.vep_total_missed,
+		vep_total_signed = excluded.vep_total_signed,
+		vep_total_missed_signatures = excluded.vep_total_missed_signatures,
+		vep_updated_at = excluded.vep_updated_at
+		WHERE validator_epochs.vep_updated_at < excluded.vep_updated_at`)
+	_, err := db.insert(q)
+	return err
+}
+
+// CompleteValidatorEpochs sets the counters of the previous epoch of the keys to the difference of the totals,
+// so the blocks after the last snapshot of the epoch are counted too
+func (db Postgres) CompleteValidatorEpochs(items []dmodels.ValidatorEpoch) error {
+	if len(items) == 0 {
+		return nil
+	}
+	rows := make([][]interface{}, len(items))
+	for i, item := range items {
+		if item.Key == "" {
+			return fmt.Errorf("field Key is empty")
+		}
+		if item.Epoch == 0 {
+			return fmt.Errorf("field Epoch is empty")
+		}
+		rows[i] = []interface{}{
+			item.Key,
+			item.Epoch - 1,
+			item.TotalProposed,
+			item.TotalMissed,
+			item.TotalSigned,
+			item.TotalMissedSignatures,
+			item.UpdatedAt,
+		}
+	}
+	q := squirrel.Insert(dmodels.ValidatorEpochsTable).
+		PrefixExpr(withValues("v",
+			[]string{"key", "epoch", "total_proposed", "total_missed", "total_signed", "total_missed_signatures", "updated_at"},
+			[]string{"varchar", "bigint", "bigint", "bigint", "bigint", "bigint", "timestamp"},
+			rows,
+		)).
+		Columns(
+			"vep_key",
+			"vep_epoch",
+			"vep_shard",
+			"vep_proposed",
+			"vep_missed",
+			"vep_signed",
+			"vep_missed_signatures",
+			"vep_updated_at",
+		).
+		Select(squirrel.Select(
+			"v.key",
+			"v.epoch",
+			"e.vep_shard",
+			"v.total_proposed - e.vep_total_proposed",
+			"v.total_missed - e.vep_total_missed",
+			"v.total_signed - e.vep_total_signed",
+			"v.total_missed_signatures - e.vep_total_missed_signatures",
+			"v.updated_at",
+		).
+			From("v").
+			// the rows saved before the totals were tracked are left as is
+			Join(fmt.Sprintf("%s e on e.vep_key = v.key and e.vep_epoch = v.epoch", dmodels.ValidatorEpochsTable)).
+			Where(`v.total_proposed >= e.vep_total_proposed and v.total_missed >= e.vep_total_missed
+				and v.total_signed >= e.vep_total_signed and v.total_missed_signatures >= e.vep_total_missed_signatures`)).
+		Suffix(`ON CONFLICT (vep_key, vep_epoch) DO UPDATE SET
+		vep_proposed = excluded.vep_proposed,
+		vep_missed = excluded.vep_missed,
+		vep_signed = excluded.vep_signed,
+		vep_missed_signatures = excluded.vep_missed_signatures,
+		vep_updated_at = excluded.vep_updated_at`)
+	_, err := db.insert(q)
+	return err
+}
+
+// GetValidatorEpochs sums the counters of the keys within every epoch and shard, the latest epochs go first
+func (db Postgres) GetValidatorEpochs(filter filters.ValidatorEpochs) (items []dmodels.ValidatorEpoch, err error) {
+	q := squirrel.Select(
+		"vep_epoch",
+		"vep_shard",
+		"sum(vep_proposed) as vep_proposed",
+		"sum(vep_missed) as vep_missed",
+		"sum(vep_signed) as vep_signed",
+		"sum(vep_missed_signatures) as vep_missed_signatures",
+		"max(vep_updated_at) as vep_updated_at",
+	).From(dmodels.ValidatorEpochsTable).
+		Where(squirrel.Eq{"vep_key": filter.Keys}).
+		GroupBy("vep_epoch", "vep_shard").
+		OrderBy("vep_epoch desc", "vep_shard")
+	if filter.Epochs != 0 {
+		q = q.Where(fmt.Sprintf("vep_epoch > (select max(vep_epoch) from %s) - ?", dmodels.ValidatorEpochsTable), filter.Epochs)
+	}
+	err = db.find(&items, q)
+	return items, err
+}
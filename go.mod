@@ -4,6 +4,7 @@ go 1.15
 
 require (
 	github.com/ElrondNetwork/elastic-indexer-go v1.2.32
+	github.com/ElrondNetwork/elrond-go-core v1.1.14
 	github.com/Masterminds/squirrel v1.5.0
 	github.com/aquasecurity/esquery v0.2.0
 	github.com/btcsuite/btcutil v1.0.2
@@ -55,16 +55,22 @@ func main() {
 	sch.AddProcessWithInterval(s.UpdateValidatorsMap, time.Minute*20)
 	sch.AddProcessWithInterval(s.UpdateStakingProviders, time.Hour)
 	sch.AddProcessWithInterval(s.UpdateNodes, time.Hour)
+	sch.AddProcessWithInterval(s.UpdateValidatorEpochs, time.Minute*10)
+	sch.AddProcessWithInterval(s.UpdateEpochSnapshot, time.Minute*10)
 	sch.AddProcessWithInterval(s.UpdateValidators, time.Hour)
 	sch.AddProcessWithInterval(s.MakeRanking, time.Hour)
-	sch.AddProcessWithInterval(s.UpdateTokens, time.Hour)
+	sch.AddProcessWithInterval(s.ReconcileDelegations, time.Minute*10)
+	sch.AddProcessWithInterval(s.UpdateDistribution, time.Hour)
+	sch.AddProcessWithInterval(s.UpdateTokens, time.Minute*10)
+	sch.AddProcessWithInterval(s.UpdateNFTMetadata, time.Minute)
+	sch.AddProcessWithInterval(s.UpdateNFTCollectionsStats, time.Hour)
 
 	w := watcher.NewWatcher(d, apiServer.WS)
 
 	g := modules.NewGroup(apiServer, prs, ds, sch, w)
 	g.Run()
 
-	gracefulStop := make(chan os.Signal)
+	gracefulStop := make(chan os.Signal, 1)
 	signal.Notify(gracefulStop, os.Interrupt, os.Kill)
 
 	<-gracefulStop
@@ -0,0 +1,259 @@
+package abi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"math/big"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	variadicType = "variadic"
+	optionalType = "optional"
+	multiType    = "multi"
+	unknownType  = "unknown"
+)
+
+type (
+	// ABI is a contract ABI in the format of elrond-wasm, only endpoints are used for decoding
+	ABI struct {
+		Name      string     `json:"name"`
+		Endpoints []Endpoint `json:"endpoints"`
+	}
+	Endpoint struct {
+		Name    string  `json:"name"`
+		Inputs  []Param `json:"inputs"`
+		Outputs []Param `json:"outputs"`
+	}
+	Param struct {
+		Name string `json:"name,omitempty"`
+		Type string `json:"type"`
+	}
+	Value struct {
+		Name  string
+		Type  string
+		Value interface{}
+	}
+	Call struct {
+		Function string
+		Args     []Value
+	}
+	// Registry keeps ABIs by contract address
+	Registry struct {
+		mu    *sync.RWMutex
+		items map[string]ABI
+	}
+)
+
+func NewRegistry() *Registry {
+	return &Registry{
+		mu:    &sync.RWMutex{},
+		items: make(map[string]ABI),
+	}
+}
+
+func (r *Registry) Set(address string, a ABI) {
+	if address == "" {
+		return
+	}
+	r.mu.Lock()
+	r.items[address] = a
+	r.mu.Unlock()
+}
+
+func (r *Registry) Get(address string) (a ABI, ok bool) {
+	r.mu.RLock()
+	a, ok = r.items[address]
+	r.mu.RUnlock()
+	return a, ok
+}
+
+// Parse unmarshals and validates ABI JSON
+func Parse(raw []byte) (a ABI, err error) {
+	err = json.Unmarshal(raw, &a)
+	if err != nil {
+		return a, fmt.Errorf("json.Unmarshal: %s", err.Error())
+	}
+	if len(a.Endpoints) == 0 {
+		return a, fmt.Errorf("no endpoints")
+	}
+	for _, e := range a.Endpoints {
+		if e.Name == "" {
+			return a, fmt.Errorf("endpoint without name")
+		}
+		for _, p := range append(append([]Param{}, e.Inputs...), e.Outputs...) {
+			if _, _, err := parseType(p.Type); err != nil {
+				return a, fmt.Errorf("endpoint %s: %s", e.Name, err.Error())
+			}
+		}
+	}
+	return a, nil
+}
+
+func (a ABI) Endpoint(name string) (e Endpoint, ok bool) {
+	for _, e := range a.Endpoints {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return e, false
+}
+
+// DecodeCall decodes function@arg1@arg2 data of the contract call
+func (a ABI) DecodeCall(parts []string) (call Call, ok bool) {
+	if len(parts) == 0 {
+		return call, false
+	}
+	e, ok := a.Endpoint(parts[0])
+	if !ok {
+		return call, false
+	}
+	return Call{Function: e.Name, Args: DecodeArgs(e.Inputs, parts[1:])}, true
+}
+
+// DecodeResults decodes return values of the function
+func (a ABI) DecodeResults(function string, args []string) []Value {
+	e, _ := a.Endpoint(function)
+	return DecodeArgs(e.Outputs, args)
+}
+
+// DecodeArgs decodes hex arguments by params, the arguments not described by params are returned as unknown
+func DecodeArgs(params []Param, args []string) (values []Value) {
+	i := 0
+	decode := func(name string, types []string) {
+		for _, t := range types {
+			if i >= len(args) {
+				return
+			}
+			values = append(values, Value{Name: name, Type: t, Value: decodeValue(t, args[i])})
+			i++
+		}
+	}
+	for _, p := range params {
+		if i >= len(args) {
+			break
+		}
+		kind, types, _ := parseType(p.Type)
+		switch kind {
+		case variadicType:
+			for i < len(args) {
+				decode(p.Name, types)
+			}
+		default:
+			decode(p.Name, types)
+		}
+	}
+	for ; i < len(args); i++ {
+		values = append(values, Value{Type: unknownType, Value: args[i]})
+	}
+	return values
+}
+
+// ReturnCode decodes the code of the result, e.g. @6f6b is ok
+func ReturnCode(arg string) string {
+	b, err := hex.DecodeString(arg)
+	if err != nil {
+		return arg
+	}
+	return string(b)
+}
+
+// parseType returns the kind of the multi-value type and the types of single values,
+// e.g. variadic<multi<bytes,BigUint>> is variadic of [bytes, BigUint]
+func parseType(t string) (kind string, types []string, err error) {
+	t = strings.TrimSpace(t)
+	if t == "" {
+		return kind, nil, fmt.Errorf("empty type")
+	}
+	for _, k := range []string{variadicType, optionalType} {
+		if strings.HasPrefix(t, k+"<") && strings.HasSuffix(t, ">") {
+			_, types, err = parseType(t[len(k)+1 : len(t)-1])
+			return k, types, err
+		}
+	}
+	if strings.HasPrefix(t, multiType+"<") && strings.HasSuffix(t, ">") {
+		for _, item := range splitTypes(t[len(multiType)+1 : len(t)-1]) {
+			_, itemTypes, err := parseType(item)
+			if err != nil {
+				return kind, nil, err
+			}
+			types = append(types, itemTypes...)
+		}
+		return multiType, types, nil
+	}
+	return "", []string{t}, nil
+}
+
+// splitTypes splits the comma separated types ignoring the commas of the nested types
+func splitTypes(s string) (types []string) {
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				types = append(types, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(types, strings.TrimSpace(s[start:]))
+}
+
+// decodeValue decodes the top-level encoded value, the types which can't be decoded are returned as hex
+func decodeValue(t string, arg string) interface{} {
+	b, err := hex.DecodeString(arg)
+	if err != nil {
+		return arg
+	}
+	switch t {
+	case "BigUint", "u8", "u16", "u32", "u64", "usize":
+		return new(big.Int).SetBytes(b).String()
+	case "BigInt", "i8", "i16", "i32", "i64", "isize":
+		n := new(big.Int).SetBytes(b)
+		if len(b) > 0 && b[0]&0x80 != 0 {
+			n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+		}
+		return n.String()
+	case "bool":
+		return len(b) > 0 && b[len(b)-1] == 1
+	case "Address":
+		address, err := node.HexToAddress(arg)
+		if err != nil || len(b) != 32 {
+			return arg
+		}
+		return address
+	case "TokenIdentifier", "EgldOrEsdtTokenIdentifier", "utf-8 string", "String", "bytes", "BoxedBytes", "ManagedBuffer":
+		if isText(b) {
+			return string(b)
+		}
+		return arg
+	default:
+		return arg
+	}
+}
+
+func hexBytes(arg string) []byte {
+	b, _ := hex.DecodeString(arg)
+	return b
+}
+
+func isText(b []byte) bool {
+	if len(b) == 0 || !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
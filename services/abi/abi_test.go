@@ -0,0 +1,53 @@
+package abi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseType(t *testing.T) {
+	kind, types, err := parseType("variadic<multi<bytes,BigUint>>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kind != variadicType || !reflect.DeepEqual(types, []string{"bytes", "BigUint"}) {
+		t.Error("wrong type", kind, types)
+	}
+}
+
+func TestDecodeArgs(t *testing.T) {
+	params := []Param{p("amount", "BigUint"), p("delta", "i64"), p("pairs", "variadic<multi<bytes,bool>>")}
+	values := DecodeArgs(params, []string{"0de0b6b3a7640000", "ff", "6b6579", "01", "6b657932", "", "aa"})
+	expected := []Value{
+		{Name: "amount", Type: "BigUint", Value: "1000000000000000000"},
+		{Name: "delta", Type: "i64", Value: "-1"},
+		{Name: "pairs", Type: "bytes", Value: "key"},
+		{Name: "pairs", Type: "bool", Value: true},
+		{Name: "pairs", Type: "bytes", Value: "key2"},
+		{Name: "pairs", Type: "bool", Value: false},
+		{Name: "pairs", Type: "bytes", Value: "aa"},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Error("not equal", values)
+	}
+}
+
+func TestDecodeTransfer(t *testing.T) {
+	parts := []string{"ESDTTransfer", "5745474c442d626434643739", "0de0b6b3a7640000", "73776170", "01"}
+	transfer, destination, call, ok := DecodeTransfer(parts, "erd1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q6shuwt")
+	if !ok {
+		t.Fatal("transfer is not decoded")
+	}
+	if transfer.Args[0].Value != "WEGLD-bd4d79" || transfer.Args[1].Value != "1000000000000000000" {
+		t.Error("wrong args", transfer.Args)
+	}
+	if destination != "erd1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q6shuwt" {
+		t.Error("wrong destination", destination)
+	}
+	if !reflect.DeepEqual(call, []string{"swap", "01"}) {
+		t.Error("wrong call", call)
+	}
+	if ReturnCode("6f6b") != "ok" {
+		t.Error("wrong return code")
+	}
+}
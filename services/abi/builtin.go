@@ -0,0 +1,215 @@
+package abi
+
+import (
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"math/big"
+)
+
+const (
+	esdtTransfer         = "ESDTTransfer"
+	esdtNFTTransfer      = "ESDTNFTTransfer"
+	multiESDTNFTTransfer = "MultiESDTNFTTransfer"
+)
+
+func p(name string, t string) Param {
+	return Param{Name: name, Type: t}
+}
+
+func e(name string, inputs ...Param) Endpoint {
+	return Endpoint{Name: name, Inputs: inputs}
+}
+
+func view(name string, output string, inputs ...Param) Endpoint {
+	return Endpoint{Name: name, Inputs: inputs, Outputs: []Param{{Type: output}}}
+}
+
+var (
+	tokenParam      = p("token_identifier", "TokenIdentifier")
+	blsKeysParam    = p("bls_keys", "variadic<bytes>")
+	propertiesParam = p("properties", "variadic<multi<bytes,bytes>>")
+
+	// Builtin are the built-in functions of the protocol which may be called on any account
+	Builtin = ABI{
+		Name: "builtin",
+		Endpoints: []Endpoint{
+			e(esdtTransfer, tokenParam, p("amount", "BigUint")),
+			e(esdtNFTTransfer, tokenParam, p("nonce", "u64"), p("quantity", "BigUint"), p("receiver", "Address")),
+			e(multiESDTNFTTransfer, p("receiver", "Address"), p("count", "u32")),
+			e("ESDTLocalMint", tokenParam, p("amount", "BigUint")),
+			e("ESDTLocalBurn", tokenParam, p("amount", "BigUint")),
+			e("ESDTNFTCreate", tokenParam, p("quantity", "BigUint"), p("name", "bytes"), p("royalties", "u32"),
+				p("hash", "bytes"), p("attributes", "bytes"), p("uris", "variadic<bytes>")),
+			e("ESDTNFTBurn", tokenParam, p("nonce", "u64"), p("quantity", "BigUint")),
+			e("ESDTNFTAddQuantity", tokenParam, p("nonce", "u64"), p("quantity", "BigUint")),
+			e("ESDTNFTAddURI", tokenParam, p("nonce", "u64"), p("uris", "variadic<bytes>")),
+			e("ESDTNFTUpdateAttributes", tokenParam, p("nonce", "u64"), p("attributes", "bytes")),
+			e("ESDTFreeze", tokenParam),
+			e("ESDTUnFreeze", tokenParam),
+			e("ESDTWipe", tokenParam),
+			e("ChangeOwnerAddress", p("owner", "Address")),
+			e("ClaimDeveloperRewards"),
+			e("SetUserName", p("name", "bytes")),
+			e("SaveKeyValue", p("pairs", "variadic<multi<bytes,bytes>>")),
+		},
+	}
+
+	// Delegation is ABI of the delegation contracts of staking providers
+	Delegation = ABI{
+		Name: "delegation",
+		Endpoints: []Endpoint{
+			e("delegate"),
+			e("unDelegate", p("amount", "BigUint")),
+			e("withdraw"),
+			e("claimRewards"),
+			e("reDelegateRewards"),
+			e("changeServiceFee", p("service_fee", "BigUint")),
+			e("modifyTotalDelegationCap", p("cap", "BigUint")),
+			e("setAutomaticActivation", p("value", "bytes")),
+			e("setCheckCapOnReDelegateRewards", p("value", "bytes")),
+			e("setMetaData", p("name", "bytes"), p("website", "bytes"), p("identifier", "bytes")),
+			e("addNodes", p("keys", "variadic<multi<bytes,bytes>>")),
+			e("removeNodes", blsKeysParam),
+			e("stakeNodes", blsKeysParam),
+			e("unStakeNodes", blsKeysParam),
+			e("unBondNodes", blsKeysParam),
+			e("unJailNodes", blsKeysParam),
+			e("reStakeUnStakedNodes", blsKeysParam),
+			view("getUserActiveStake", "BigUint", p("address", "Address")),
+			view("getUserUnStakedValue", "BigUint", p("address", "Address")),
+			view("getUserUnBondable", "BigUint", p("address", "Address")),
+			view("getClaimableRewards", "BigUint", p("address", "Address")),
+			view("getTotalCumulatedRewardsForUser", "BigUint", p("address", "Address")),
+			view("getTotalActiveStake", "BigUint"),
+			view("getTotalUnStaked", "BigUint"),
+			view("getTotalCumulatedRewards", "BigUint"),
+			view("getNumUsers", "u64"),
+		},
+	}
+
+	// LegacyDelegation is ABI of the genesis delegation contract
+	LegacyDelegation = ABI{
+		Name: "legacy delegation",
+		Endpoints: []Endpoint{
+			e("stake"),
+			e("unStake", p("amount", "BigUint")),
+			e("unBond"),
+			e("claimRewards"),
+			view("getUserActiveStake", "BigUint", p("address", "Address")),
+			view("getUserInactiveStake", "BigUint", p("address", "Address")),
+			view("getClaimableRewards", "BigUint", p("address", "Address")),
+			view("getTotalActiveStake", "BigUint"),
+			view("getNumUsers", "u64"),
+		},
+	}
+
+	DelegationManager = ABI{
+		Name: "delegation manager",
+		Endpoints: []Endpoint{
+			e("createNewDelegationContract", p("total_delegation_cap", "BigUint"), p("service_fee", "BigUint")),
+			e("makeNewContractFromValidatorData", p("total_delegation_cap", "BigUint"), p("service_fee", "BigUint")),
+			e("getAllContractAddresses"),
+			e("getContractConfig"),
+		},
+	}
+
+	// Auction is ABI of the validator system contract
+	Auction = ABI{
+		Name: "auction",
+		Endpoints: []Endpoint{
+			e("stake", p("num_nodes", "u32"), p("keys", "variadic<multi<bytes,bytes>>")),
+			e("unStake", blsKeysParam),
+			e("unStakeNodes", blsKeysParam),
+			e("unStakeTokens", p("amount", "BigUint")),
+			e("unBond", blsKeysParam),
+			e("unBondNodes", blsKeysParam),
+			e("unBondTokens", p("amount", "BigUint")),
+			e("reStakeUnStakedNodes", blsKeysParam),
+			e("unJail", blsKeysParam),
+			e("claim"),
+			e("changeRewardAddress", p("address", "Address")),
+			view("getTotalStaked", "BigUint"),
+			view("getUnStakedTokensList", "variadic<BigUint>"),
+			view("getBlsKeysStatus", "variadic<multi<bytes,bytes>>", p("address", "Address")),
+		},
+	}
+
+	Staking = ABI{
+		Name: "staking",
+		Endpoints: []Endpoint{
+			view("getQueueIndex", "u32", p("bls_key", "bytes")),
+			view("getQueueSize", "u32"),
+			view("getOwner", "Address", p("bls_key", "bytes")),
+			view("getRemainingUnBondPeriod", "u64", p("bls_key", "bytes")),
+			view("getTotalNumberOfRegisteredNodes", "u64"),
+		},
+	}
+
+	ESDT = ABI{
+		Name: "esdt",
+		Endpoints: []Endpoint{
+			e("issue", p("name", "bytes"), p("ticker", "bytes"), p("supply", "BigUint"), p("decimals", "u32"), propertiesParam),
+			e("issueSemiFungible", p("name", "bytes"), p("ticker", "bytes"), propertiesParam),
+			e("issueNonFungible", p("name", "bytes"), p("ticker", "bytes"), propertiesParam),
+			e("registerMetaESDT", p("name", "bytes"), p("ticker", "bytes"), p("decimals", "u32"), propertiesParam),
+			e("registerAndSetAllRoles", p("name", "bytes"), p("ticker", "bytes"), p("type", "bytes"), p("decimals", "u32")),
+			e("setSpecialRole", tokenParam, p("address", "Address"), p("roles", "variadic<bytes>")),
+			e("unSetSpecialRole", tokenParam, p("address", "Address"), p("roles", "variadic<bytes>")),
+			e("transferOwnership", tokenParam, p("owner", "Address")),
+			e("transferNFTCreateRole", tokenParam, p("from", "Address"), p("to", "Address")),
+			e("stopNFTCreate", tokenParam),
+			e("changeSFTToMetaESDT", tokenParam, p("decimals", "u32")),
+			e("controlChanges", tokenParam, propertiesParam),
+			e("mint", tokenParam, p("amount", "BigUint"), p("receiver", "optional<Address>")),
+			e("ESDTBurn", tokenParam, p("amount", "BigUint")),
+			e("pause", tokenParam),
+			e("unPause", tokenParam),
+			e("freeze", tokenParam, p("address", "Address")),
+			e("unFreeze", tokenParam, p("address", "Address")),
+			e("wipe", tokenParam, p("address", "Address")),
+			e("freezeSingleNFT", tokenParam, p("nonce", "u64"), p("address", "Address")),
+			e("unFreezeSingleNFT", tokenParam, p("nonce", "u64"), p("address", "Address")),
+			e("wipeSingleNFT", tokenParam, p("nonce", "u64"), p("address", "Address")),
+			view("getTokenProperties", "variadic<bytes>", tokenParam),
+			view("getSpecialRoles", "variadic<bytes>", tokenParam),
+			view("getAllAddressesAndRoles", "variadic<bytes>", tokenParam),
+		},
+	}
+)
+
+// DecodeTransfer decodes ESDT transfer functions, returns the destination of the transfer and the contract call made with it if any
+func DecodeTransfer(parts []string, receiver string) (transfer Call, destination string, call []string, ok bool) {
+	var count int
+	switch parts[0] {
+	case esdtTransfer:
+		count, destination = 2, receiver
+	case esdtNFTTransfer:
+		count = 4
+		if len(parts) > 4 {
+			destination, _ = node.HexToAddress(parts[4])
+		}
+	case multiESDTNFTTransfer:
+		if len(parts) < 3 {
+			return transfer, destination, nil, false
+		}
+		destination, _ = node.HexToAddress(parts[1])
+		n := new(big.Int).SetBytes(hexBytes(parts[2]))
+		if !n.IsInt64() || n.Int64() > int64(len(parts)) {
+			return transfer, destination, nil, false
+		}
+		count = 2 + int(n.Int64())*3
+	default:
+		return transfer, destination, nil, false
+	}
+	e, _ := Builtin.Endpoint(parts[0])
+	params := e.Inputs
+	if parts[0] == multiESDTNFTTransfer {
+		params = append(params, p("transfers", "variadic<multi<TokenIdentifier,u64,BigUint>>"))
+	}
+	args := parts[1:]
+	if len(args) > count {
+		// the function of the call is hex encoded
+		call = append([]string{string(hexBytes(args[count]))}, args[count+1:]...)
+		args = args[:count]
+	}
+	return Call{Function: parts[0], Args: DecodeArgs(params, args)}, destination, call, true
+}
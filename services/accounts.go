@@ -62,12 +62,16 @@ func (s *ServiceFacade) GetAccount(address string) (account smodels.Account, err
 	if err != nil {
 		return account, fmt.Errorf("node.GetClaimableRewards: %s", err.Error())
 	}
+	stake, err := s.getStakeBreakdown(address)
+	if err != nil {
+		return account, fmt.Errorf("getStakeBreakdown: %s", err.Error())
+	}
 	delegations := s.parser.GetDelegations(address)
 	var stakeProviders []smodels.AccountStakingProvider
-	for validator, stake := range delegations {
+	for validator, amount := range delegations {
 		stakeProviders = append(stakeProviders, smodels.AccountStakingProvider{
 			Provider: validator,
-			Stake:    stake,
+			Stake:    amount,
 		})
 	}
 	balance, _ := decimal.NewFromString(acc.Balance)
@@ -80,6 +84,7 @@ func (s *ServiceFacade) GetAccount(address string) (account smodels.Account, err
 		RewardsClaimed:   decimal.Zero, // todo
 		ClaimableRewards: node.ValueToEGLD(claimableRewards),
 		StakingProviders: stakeProviders,
+		Stake:            stake,
 	}, nil
 }
 
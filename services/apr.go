@@ -0,0 +1,118 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/apr"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/shopspring/decimal"
+	"net/http"
+	"time"
+)
+
+// protocolSustainabilityShare is a share of the minted tokens which isn't given to the validators
+var protocolSustainabilityShare = decimal.NewFromFloat(0.1)
+
+// aprNetwork collects the state of the network used by the APR engine
+func (s *ServiceFacade) aprNetwork(nodes []smodels.Node) (network apr.Network, err error) {
+	economics, err := s.node.GetNetworkEconomics()
+	if err != nil {
+		return network, fmt.Errorf("node.GetNetworkEconomics: %s", err.Error())
+	}
+	epochDuration := time.Duration(s.networkConfig.ErdRoundsPerEpoch*s.networkConfig.ErdRoundDuration) * time.Millisecond
+	if epochDuration == 0 {
+		return network, fmt.Errorf("epoch duration is zero")
+	}
+	topUpFactor, err := decimal.NewFromString(s.networkConfig.ErdTopUpFactor)
+	if err != nil {
+		return network, fmt.Errorf("decimal.NewFromString(top up factor): %s", err.Error())
+	}
+	gradientPoint, err := decimal.NewFromString(s.networkConfig.ErdRewardsTopUpGradientPoint)
+	if err != nil {
+		return network, fmt.Errorf("decimal.NewFromString(gradient point): %s", err.Error())
+	}
+	network = apr.Network{
+		RewardsPerEpoch:    node.ValueToEGLD(economics.ErdInflation).Mul(decimal.New(1, 0).Sub(protocolSustainabilityShare)),
+		EpochsPerYear:      decimal.New(int64(time.Hour*24*365), 0).Div(decimal.New(int64(epochDuration), 0)),
+		TopUp:              node.ValueToEGLD(economics.ErdTotalTopUpValue),
+		TopUpFactor:        topUpFactor,
+		TopUpGradientPoint: node.ValueToEGLD(gradientPoint),
+	}
+	for _, n := range nodes {
+		if n.Type == smodels.NodeTypeValidator && n.Status == smodels.NodeStatusEligible {
+			network.Nodes++
+		}
+	}
+	return network, nil
+}
+
+// ownerStake returns the locked stake and the top-up of the nodes owner,
+// the stake of the owner is copied to each node, so any node of the owner has the same values
+func ownerStake(nodes []smodels.Node) (locked decimal.Decimal, topUp decimal.Decimal) {
+	if len(nodes) == 0 {
+		return decimal.Zero, decimal.Zero
+	}
+	return nodes[0].Locked, nodes[0].TopUp
+}
+
+// aprValidator returns the eligible nodes of the provider and its top-up
+func aprValidator(provider smodels.StakingProvider, nodes []smodels.Node) apr.Validator {
+	v := apr.Validator{
+		ServiceFee: provider.ServiceFee.Div(decimal.New(100, 0)),
+	}
+	for _, n := range nodes {
+		if n.Status == smodels.NodeStatusEligible {
+			v.Nodes++
+		}
+	}
+	_, v.TopUp = ownerStake(nodes)
+	return v
+}
+
+// GetStakingCalculation projects rewards of the amount delegated to the provider,
+// the network APR (without the service fee) is used if the provider isn't set
+func (s *ServiceFacade) GetStakingCalculation(amount decimal.Decimal, provider string) (calc smodels.StakingCalculation, err error) {
+	var nodes []smodels.Node
+	err = s.getCache(dmodels.NodesStorageKey, &nodes)
+	if err != nil {
+		return calc, fmt.Errorf("getCache(nodes): %s", err.Error())
+	}
+	network, err := s.aprNetwork(nodes)
+	if err != nil {
+		return calc, fmt.Errorf("aprNetwork: %s", err.Error())
+	}
+	calc = smodels.StakingCalculation{
+		Provider: provider,
+		Amount:   amount,
+		APR:      network.NetworkAPR(),
+	}
+	if provider != "" {
+		p, err := s.GetStakingProvider(provider)
+		if err != nil {
+			return calc, err
+		}
+		var providerNodes []smodels.Node
+		for _, n := range nodes {
+			if n.Owner == provider {
+				providerNodes = append(providerNodes, n)
+			}
+		}
+		if len(providerNodes) == 0 {
+			return calc, smodels.Error{
+				Err:      "not found",
+				Msg:      "provider has no nodes",
+				HttpCode: http.StatusNotFound,
+			}
+		}
+		v := aprValidator(p, providerNodes)
+		// the delegated amount becomes the top-up of the provider
+		v.TopUp = v.TopUp.Add(amount)
+		network.TopUp = network.TopUp.Add(amount)
+		calc.APR = network.APR(v)
+	}
+	calc.Daily = apr.Rewards(amount, calc.APR, 1)
+	calc.Monthly = apr.Rewards(amount, calc.APR, 30)
+	calc.Yearly = apr.Rewards(amount, calc.APR, 365)
+	return calc, nil
+}
@@ -0,0 +1,81 @@
+package apr
+
+import (
+	"github.com/shopspring/decimal"
+	"math"
+)
+
+var (
+	// NodePrice is the base stake of the single node in EGLD
+	NodePrice = decimal.New(2500, 0)
+
+	hundred = decimal.New(100, 0)
+)
+
+type (
+	// Network is the state used to split the epoch rewards, all the amounts are in EGLD
+	Network struct {
+		// RewardsPerEpoch are the rewards of the validators for the single epoch
+		RewardsPerEpoch decimal.Decimal
+		EpochsPerYear   decimal.Decimal
+		// Nodes is the number of eligible nodes, each of them has the same base stake
+		Nodes uint64
+		TopUp decimal.Decimal
+		// TopUpFactor is the max share of the rewards given for the top-up
+		TopUpFactor decimal.Decimal
+		// TopUpGradientPoint is the top-up which gets a half of the max top-up rewards
+		TopUpGradientPoint decimal.Decimal
+	}
+	Validator struct {
+		Nodes uint64
+		TopUp decimal.Decimal
+		// ServiceFee is a share of the rewards taken by the staking provider, 0.1 is 10%
+		ServiceFee decimal.Decimal
+	}
+)
+
+// TopUpRewards returns rewards for the top-up within the epoch, they grow as 2*k/π*atan(topUp/p) where k is
+// the max top-up rewards and p is the gradient point. The rest of the rewards is split equally between the nodes
+func (n Network) TopUpRewards() decimal.Decimal {
+	if !n.TopUpGradientPoint.IsPositive() {
+		return decimal.Zero
+	}
+	maxRewards := n.RewardsPerEpoch.Mul(n.TopUpFactor)
+	x, _ := n.TopUp.Div(n.TopUpGradientPoint).Float64()
+	ratio := decimal.NewFromFloat(2 / math.Pi * math.Atan(x))
+	return maxRewards.Mul(ratio)
+}
+
+// EpochRewards returns rewards of the validator (before the service fee) for the single epoch
+func (n Network) EpochRewards(v Validator) decimal.Decimal {
+	if n.Nodes == 0 {
+		return decimal.Zero
+	}
+	topUpRewards := n.TopUpRewards()
+	baseRewards := n.RewardsPerEpoch.Sub(topUpRewards)
+	rewards := baseRewards.Mul(decimal.New(int64(v.Nodes), 0)).Div(decimal.New(int64(n.Nodes), 0))
+	if n.TopUp.IsPositive() {
+		rewards = rewards.Add(topUpRewards.Mul(v.TopUp).Div(n.TopUp))
+	}
+	return rewards
+}
+
+// APR returns the annual percentage rate of the delegators (after the service fee) in percents
+func (n Network) APR(v Validator) decimal.Decimal {
+	stake := NodePrice.Mul(decimal.New(int64(v.Nodes), 0)).Add(v.TopUp)
+	if !stake.IsPositive() {
+		return decimal.Zero
+	}
+	yearly := n.EpochRewards(v).Mul(n.EpochsPerYear).Mul(decimal.New(1, 0).Sub(v.ServiceFee))
+	return yearly.Div(stake).Mul(hundred).Round(2)
+}
+
+// NetworkAPR returns the average annual percentage rate of the whole stake in percents
+func (n Network) NetworkAPR() decimal.Decimal {
+	return n.APR(Validator{Nodes: n.Nodes, TopUp: n.TopUp})
+}
+
+// Rewards projects rewards of the amount staked with the APR for the number of days
+func Rewards(amount decimal.Decimal, apr decimal.Decimal, days int64) decimal.Decimal {
+	return amount.Mul(apr).Div(hundred).Mul(decimal.New(days, 0)).Div(decimal.New(365, 0))
+}
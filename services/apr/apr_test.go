@@ -0,0 +1,55 @@
+package apr
+
+import (
+	"github.com/shopspring/decimal"
+	"testing"
+)
+
+func testNetwork() Network {
+	return Network{
+		RewardsPerEpoch:    decimal.New(5000, 0),
+		EpochsPerYear:      decimal.New(365, 0),
+		Nodes:              3200,
+		TopUp:              decimal.New(2000000, 0),
+		TopUpFactor:        decimal.NewFromFloat(0.25),
+		TopUpGradientPoint: decimal.New(2000000, 0),
+	}
+}
+
+func TestTopUpRewards(t *testing.T) {
+	n := testNetwork()
+	// atan(1) = π/4, so a half of the max top-up rewards is given at the gradient point
+	expected := decimal.NewFromFloat(625)
+	if got := n.TopUpRewards().Round(6); !got.Equal(expected) {
+		t.Errorf("TopUpRewards: expected %s, got %s", expected, got)
+	}
+	n.TopUpGradientPoint = decimal.Zero
+	if got := n.TopUpRewards(); !got.IsZero() {
+		t.Errorf("TopUpRewards without gradient point: expected 0, got %s", got)
+	}
+}
+
+func TestAPR(t *testing.T) {
+	n := testNetwork()
+	v := Validator{Nodes: 32, TopUp: decimal.New(20000, 0)}
+	// base: (5000-625)*32/3200 = 43.75, top-up: 625*20000/2000000 = 6.25, yearly: 50*365 = 18250 on 100000 EGLD
+	expected := decimal.NewFromFloat(18.25)
+	if got := n.APR(v); !got.Equal(expected) {
+		t.Errorf("APR: expected %s, got %s", expected, got)
+	}
+	v.ServiceFee = decimal.NewFromFloat(0.1)
+	expected = decimal.NewFromFloat(16.43)
+	if got := n.APR(v); !got.Equal(expected) {
+		t.Errorf("APR with fee: expected %s, got %s", expected, got)
+	}
+	if got := n.APR(Validator{}); !got.IsZero() {
+		t.Errorf("APR without stake: expected 0, got %s", got)
+	}
+}
+
+func TestRewards(t *testing.T) {
+	got := Rewards(decimal.New(1000, 0), decimal.New(10, 0), 365)
+	if !got.Equal(decimal.New(100, 0)) {
+		t.Errorf("Rewards: expected 100, got %s", got)
+	}
+}
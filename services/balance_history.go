@@ -0,0 +1,103 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/derrors"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/dao/postgres"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// GetBalanceHistory restores balances at the end of each interval going back from the current balance
+func (s *ServiceFacade) GetBalanceHistory(filter filters.BalanceHistory) (items []smodels.RangeItem, err error) {
+	balance, precision, err := s.getCurrentBalance(filter.Address, filter.Token)
+	if err != nil {
+		return nil, fmt.Errorf("getCurrentBalance: %s", err.Error())
+	}
+	changesAfter, err := s.dao.GetBalanceChangesTotal(filter)
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetBalanceChangesTotal: %s", err.Error())
+	}
+	// the first point is the start of the interval, so its changes are summed from the start too
+	filter.From = smodels.NewTime(truncateTime(filter.From.Time, filter.Interval))
+	sums, err := s.dao.GetBalanceChangesSums(filter)
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetBalanceChangesSums: %s", err.Error())
+	}
+	changes := make(map[int64]decimal.Decimal)
+	for _, sum := range sums {
+		changes[sum.Time.Unix()] = sum.Amount
+	}
+	var points []time.Time
+	for t := filter.From.Time; !t.After(filter.To.Time); t = nextTime(t, filter.Interval) {
+		points = append(points, t)
+	}
+	balance = balance.Sub(changesAfter)
+	items = make([]smodels.RangeItem, len(points))
+	for i := len(points) - 1; i >= 0; i-- {
+		items[i] = smodels.RangeItem{
+			Value: balance.Shift(-precision),
+			Time:  smodels.NewTime(points[i]),
+		}
+		balance = balance.Sub(changes[points[i].Unix()])
+	}
+	return items, nil
+}
+
+func (s *ServiceFacade) getCurrentBalance(address string, token string) (balance decimal.Decimal, precision int32, err error) {
+	if token == dmodels.EGLDToken {
+		acc, err := s.dao.GetAccount(address)
+		if err != nil {
+			if err == derrors.NotFound {
+				return decimal.Zero, node.Precision, nil
+			}
+			return balance, precision, fmt.Errorf("dao.GetAccount: %s", err.Error())
+		}
+		balance, _ = decimal.NewFromString(acc.Balance)
+		return balance, node.Precision, nil
+	}
+	collection, nonce := node.SplitTokenIdentifier(token)
+	balance, err = s.node.GetESDTBalance(address, collection, nonce)
+	if err != nil {
+		// the node responds with 404 when the account doesn't hold the token anymore
+		if err != node.ErrNotFound {
+			return balance, precision, fmt.Errorf("node.GetESDTBalance: %s", err.Error())
+		}
+		balance = decimal.Zero
+	}
+	t, err := s.dao.GetToken(collection)
+	if err != nil {
+		if err.Error() == postgres.NoRowsError {
+			return balance, 0, nil
+		}
+		return balance, precision, fmt.Errorf("dao.GetToken: %s", err.Error())
+	}
+	return balance, int32(t.Decimals), nil
+}
+
+func truncateTime(t time.Time, interval string) time.Time {
+	t = t.UTC()
+	switch interval {
+	case filters.HourInterval:
+		return t.Truncate(time.Hour)
+	case filters.MonthInterval:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func nextTime(t time.Time, interval string) time.Time {
+	switch interval {
+	case filters.HourInterval:
+		return t.Add(time.Hour)
+	case filters.MonthInterval:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
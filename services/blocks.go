@@ -24,7 +24,8 @@ func (s *ServiceFacade) GetBlock(hash string) (block smodels.Block, err error) {
 		return block, fmt.Errorf("dao.GetBlock: %s", err.Error())
 	}
 	esValidatorsKeys, err := s.dao.ValidatorsKeys(uint64(dBlock.ShardID), uint64(dBlock.Epoch))
-	if err != nil {
+	// the block is returned without the proposer and validators if the backend doesn't have the consensus group
+	if err != nil && err != derrors.NotSupported {
 		return block, fmt.Errorf("es.ValidatorsKeys: %s", err.Error())
 	}
 	var validatorsKeys []string
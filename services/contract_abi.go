@@ -0,0 +1,151 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/config"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/abi"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// newABIRegistry registers ABIs of the system contracts, ABIs of delegation contracts are added with staking providers
+func newABIRegistry(contracts config.Contracts) *abi.Registry {
+	r := abi.NewRegistry()
+	r.Set(contracts.Staking, abi.Staking)
+	r.Set(contracts.DelegationManager, abi.DelegationManager)
+	r.Set(contracts.Delegation, abi.LegacyDelegation)
+	r.Set(contracts.Auction, abi.Auction)
+	r.Set(contracts.ESDTContract, abi.ESDT)
+	return r
+}
+
+func (s *ServiceFacade) loadContractABIs() error {
+	items, err := s.dao.GetContractABIs()
+	if err != nil {
+		return fmt.Errorf("dao.GetContractABIs: %s", err.Error())
+	}
+	for _, item := range items {
+		a, err := abi.Parse(item.ABI)
+		if err != nil {
+			return fmt.Errorf("abi.Parse(%s): %s", item.Address, err.Error())
+		}
+		s.abis.Set(item.Address, a)
+	}
+	return nil
+}
+
+// UpdateContractABI saves uploaded ABI JSON of the contract, it overrides the built-in one
+func (s *ServiceFacade) UpdateContractABI(address string, raw []byte) error {
+	a, err := abi.Parse(raw)
+	if err != nil {
+		return smodels.Error{
+			Err:      "bad_request",
+			Msg:      fmt.Sprintf("invalid abi: %s", err.Error()),
+			HttpCode: http.StatusBadRequest,
+		}
+	}
+	err = s.dao.UpdateContractABI(dmodels.ContractABI{
+		Address:   address,
+		ABI:       raw,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("dao.UpdateContractABI: %s", err.Error())
+	}
+	s.abis.Set(address, a)
+	return nil
+}
+
+func (s *ServiceFacade) GetContractABI(address string) (contractABI abi.ABI, err error) {
+	contractABI, ok := s.abis.Get(address)
+	if !ok {
+		return contractABI, smodels.Error{
+			Err:      "not found",
+			Msg:      "abi not found",
+			HttpCode: http.StatusNotFound,
+		}
+	}
+	return contractABI, nil
+}
+
+// decodeData decodes the contract call, ESDT transfers are decoded with the call made with them
+func (s *ServiceFacade) decodeData(receiver string, data string) *smodels.DecodedData {
+	parts := strings.Split(data, "@")
+	if data == "" || parts[0] == "" {
+		return nil
+	}
+	transfer, destination, call, ok := abi.DecodeTransfer(parts, receiver)
+	if !ok {
+		return s.decodeCall(receiver, parts)
+	}
+	decoded := &smodels.DecodedData{
+		Function:  transfer.Function,
+		Arguments: toDecodedValues(transfer.Args),
+	}
+	if len(call) > 0 {
+		decoded.Call = s.decodeCall(destination, call)
+		if decoded.Call == nil {
+			decoded.Call = &smodels.DecodedData{Function: call[0]}
+		}
+	}
+	return decoded
+}
+
+func (s *ServiceFacade) decodeCall(address string, parts []string) *smodels.DecodedData {
+	contractABI, _ := s.abis.Get(address)
+	call, ok := contractABI.DecodeCall(parts)
+	if !ok {
+		call, ok = abi.Builtin.DecodeCall(parts)
+	}
+	if !ok {
+		return nil
+	}
+	return &smodels.DecodedData{
+		Function:  call.Function,
+		Arguments: toDecodedValues(call.Args),
+	}
+}
+
+// decodeResult decodes @<return code>@<value>... result of the contract function
+func (s *ServiceFacade) decodeResult(contract string, function string, data string) *smodels.DecodedData {
+	parts := strings.Split(data, "@")
+	if len(parts) < 2 || parts[0] != "" {
+		return nil
+	}
+	contractABI, _ := s.abis.Get(contract)
+	return &smodels.DecodedData{
+		Function:   function,
+		ReturnCode: abi.ReturnCode(parts[1]),
+		Results:    toDecodedValues(contractABI.DecodeResults(function, parts[2:])),
+	}
+}
+
+// decodeSCResult decodes the contract call of the result or the value returned to the call of the tx
+func (s *ServiceFacade) decodeSCResult(r smodels.ScResult, tx *smodels.DecodedData) *smodels.DecodedData {
+	if !strings.HasPrefix(r.Data, "@") {
+		return s.decodeData(r.To, r.Data)
+	}
+	var function string
+	if tx != nil {
+		function = tx.Function
+		if tx.Call != nil {
+			function = tx.Call.Function
+		}
+	}
+	return s.decodeResult(r.From, function, r.Data)
+}
+
+func toDecodedValues(values []abi.Value) []smodels.DecodedValue {
+	result := make([]smodels.DecodedValue, len(values))
+	for i, v := range values {
+		result[i] = smodels.DecodedValue{
+			Name:  v.Name,
+			Type:  v.Type,
+			Value: v.Value,
+		}
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/dao/postgres"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"net/http"
+)
+
+// GetContracts returns smart contracts ordered by the number of calls
+func (s *ServiceFacade) GetContracts(filter filters.Contracts) (items smodels.Pagination, err error) {
+	dContracts, err := s.dao.GetContracts(filter)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetContracts: %s", err.Error())
+	}
+	total, err := s.dao.GetContractsTotal(filter)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetContractsTotal: %s", err.Error())
+	}
+	contracts := make([]smodels.Contract, len(dContracts))
+	for i, c := range dContracts {
+		contracts[i] = toContractSModel(c)
+	}
+	return smodels.Pagination{
+		Items: contracts,
+		Count: total,
+	}, nil
+}
+
+func (s *ServiceFacade) GetContract(address string) (contract smodels.Contract, err error) {
+	c, err := s.dao.GetContract(address)
+	if err != nil {
+		if err.Error() == postgres.NoRowsError {
+			return contract, smodels.Error{
+				Err:      "not found",
+				Msg:      "contract not found",
+				HttpCode: http.StatusNotFound,
+			}
+		}
+		return contract, fmt.Errorf("dao.GetContract: %s", err.Error())
+	}
+	return toContractSModel(c), nil
+}
+
+// GetContractCalls returns the functions of the contract ordered by the number of calls
+func (s *ServiceFacade) GetContractCalls(filter filters.ContractCalls) (items smodels.Pagination, err error) {
+	dCalls, err := s.dao.GetContractCalls(filter)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetContractCalls: %s", err.Error())
+	}
+	total, err := s.dao.GetContractCallsTotal(filter)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetContractCallsTotal: %s", err.Error())
+	}
+	calls := make([]smodels.ContractCall, len(dCalls))
+	for i, c := range dCalls {
+		calls[i] = smodels.ContractCall{
+			Function:   c.Function,
+			Calls:      c.Calls,
+			LastCallAt: smodels.NewTime(c.LastCallAt),
+		}
+	}
+	return smodels.Pagination{
+		Items: calls,
+		Count: total,
+	}, nil
+}
+
+func toContractSModel(c dmodels.ContractActivity) smodels.Contract {
+	return smodels.Contract{
+		Address:       c.Address,
+		Deployer:      c.Deployer,
+		Owner:         c.Owner,
+		CodeHash:      c.CodeHash,
+		DeployTxHash:  c.DeployTxHash,
+		UpgradeTxHash: c.UpgradeTxHash,
+		Upgrades:      c.Upgrades,
+		Calls:         c.Calls,
+		DeployedAt:    smodels.NewTime(c.DeployedAt),
+		UpdatedAt:     smodels.NewTime(c.UpdatedAt),
+		LastCallAt:    smodels.NewTime(c.LastCallAt),
+	}
+}
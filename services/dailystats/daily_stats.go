@@ -24,6 +24,12 @@ const (
 	TotalTransactionsKey = "total_transactions"
 	TopUpAmountKey       = "top_up"
 	TotalDelegatorsKey   = "delegators"
+	HoldersKey           = "holders"
+	GiniKey              = "gini"
+	Top100ShareKey       = "top_100_share"
+	StakeDirectKey       = "stake_direct"
+	StakeLegacyKey       = "stake_legacy"
+	StakeProvidersKey    = "stake_providers"
 )
 
 type (
@@ -57,6 +63,8 @@ func NewDailyStats(cfg config.Config, d dao.DAO) (*DailyStats, error) {
 		ds.GetTotalAccounts,
 		ds.GetTotalTransactions,
 		ds.GetTotalDelegators,
+		ds.GetDistribution,
+		ds.GetStakeSources,
 	}
 	return ds, nil
 }
@@ -92,6 +100,14 @@ func (ds *DailyStats) Run() error {
 			if err != nil {
 				log.Error("DailyStats: CreateDailyStats: %s", err.Error())
 			}
+			err = ds.collectTokenStats(initTime)
+			if err != nil {
+				log.Error("DailyStats: collectTokenStats: %s", err.Error())
+			}
+			err = ds.collectFeeStats(initTime)
+			if err != nil {
+				log.Error("DailyStats: collectFeeStats: %s", err.Error())
+			}
 		}
 		log.Info("DailyStats: collection has been over, duration: %s", time.Now().Sub(initTime))
 	}
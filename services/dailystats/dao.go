@@ -47,3 +47,51 @@ func (ds *DailyStats) GetTotalDelegators() (map[string]decimal.Decimal, error) {
 		TotalDelegatorsKey: decimal.NewFromInt(int64(total)),
 	}, nil
 }
+
+func (ds *DailyStats) GetDistribution() (map[string]decimal.Decimal, error) {
+	var distribution smodels.Distribution
+	value, err := ds.dao.GetStorageValue(dmodels.DistributionStorageKey)
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetStorageValue: %s", err.Error())
+	}
+	err = json.Unmarshal([]byte(value), &distribution)
+	if err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %s", err.Error())
+	}
+	stats := map[string]decimal.Decimal{
+		HoldersKey: decimal.NewFromInt(int64(distribution.Holders)),
+		GiniKey:    distribution.Gini,
+	}
+	for _, share := range distribution.TopShares {
+		if share.Top == 100 {
+			stats[Top100ShareKey] = share.Share
+		}
+	}
+	return stats, nil
+}
+
+func (ds *DailyStats) GetStakeSources() (map[string]decimal.Decimal, error) {
+	items, err := ds.dao.GetStakeSources(filters.StakeSources{
+		Legacy: ds.cfg.Contracts.Delegation,
+		Direct: []string{ds.cfg.Contracts.Auction, ds.cfg.Contracts.Staking},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetStakeSources: %s", err.Error())
+	}
+	stats := map[string]decimal.Decimal{
+		StakeDirectKey:    decimal.Zero,
+		StakeLegacyKey:    decimal.Zero,
+		StakeProvidersKey: decimal.Zero,
+	}
+	for _, item := range items {
+		switch item.Source {
+		case dmodels.DirectStakeSource:
+			stats[StakeDirectKey] = item.Amount
+		case dmodels.LegacyStakeSource:
+			stats[StakeLegacyKey] = item.Amount
+		case dmodels.ProvidersStakeSource:
+			stats[StakeProvidersKey] = item.Amount
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,68 @@
+package dailystats
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const (
+	FeesKey          = "fees"
+	MedianFeeKey     = "median_fee"
+	P90FeeKey        = "p90_fee"
+	GasEfficiencyKey = "gas_efficiency"
+	ShardFeesKey     = "fees_shard_%d"
+	ContractFeesKey  = "fees_contract_%s"
+
+	topFeeContracts = 20
+	// fees of the single transactions are needed only for the daily aggregation
+	txFeesRetention = time.Hour * 24 * 7
+)
+
+// collectFeeStats saves fee analytics of the previous day: total, median and p90 fees,
+// the share of the used gas, fees per shard and fees of the top smart contracts
+func (ds *DailyStats) collectFeeStats(t time.Time) error {
+	from := t.Add(-time.Hour * 24)
+	fees, err := ds.dao.GetFeeStats(from, t)
+	if err != nil {
+		return fmt.Errorf("dao.GetFeeStats: %s", err.Error())
+	}
+	gasEfficiency := decimal.Zero
+	if fees.GasLimit.IsPositive() {
+		gasEfficiency = fees.GasUsed.Div(fees.GasLimit).Mul(decimal.New(100, 0))
+	}
+	stats := []dmodels.DailyStat{
+		{Title: FeesKey, Value: node.ValueToEGLD(fees.Total)},
+		{Title: MedianFeeKey, Value: node.ValueToEGLD(fees.Median.Truncate(0))},
+		{Title: P90FeeKey, Value: node.ValueToEGLD(fees.P90.Truncate(0))},
+		{Title: GasEfficiencyKey, Value: gasEfficiency},
+	}
+	shards, err := ds.dao.GetShardFees(from, t)
+	if err != nil {
+		return fmt.Errorf("dao.GetShardFees: %s", err.Error())
+	}
+	for _, s := range shards {
+		stats = append(stats, dmodels.DailyStat{Title: fmt.Sprintf(ShardFeesKey, s.Shard), Value: node.ValueToEGLD(s.Total)})
+	}
+	contracts, err := ds.dao.GetTopFeeContracts(from, t, topFeeContracts)
+	if err != nil {
+		return fmt.Errorf("dao.GetTopFeeContracts: %s", err.Error())
+	}
+	for _, c := range contracts {
+		stats = append(stats, dmodels.DailyStat{Title: fmt.Sprintf(ContractFeesKey, c.Contract), Value: node.ValueToEGLD(c.Total)})
+	}
+	for i := range stats {
+		stats[i].CreatedAt = t
+	}
+	err = ds.dao.CreateDailyStats(stats)
+	if err != nil {
+		return fmt.Errorf("dao.CreateDailyStats: %s", err.Error())
+	}
+	err = ds.dao.DeleteTxFees(t.Add(-txFeesRetention))
+	if err != nil {
+		return fmt.Errorf("dao.DeleteTxFees: %s", err.Error())
+	}
+	return nil
+}
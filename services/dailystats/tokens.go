@@ -0,0 +1,63 @@
+package dailystats
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const (
+	TokenHoldersKey   = "holders"
+	TokenTransfersKey = "transfers"
+	TokenVolumeKey    = "volume"
+)
+
+// collectTokenStats saves holders of every token at the moment t and its transfers for the previous day,
+// the supply at the moment t closes the previous day of the supply history, so the tokens without mints and burns have it too
+func (ds *DailyStats) collectTokenStats(t time.Time) error {
+	tokens, err := ds.dao.GetTokens(filters.Tokens{})
+	if err != nil {
+		return fmt.Errorf("dao.GetTokens: %s", err.Error())
+	}
+	sums, err := ds.dao.GetTokenTransfersSums(t.Add(-time.Hour*24), t)
+	if err != nil {
+		return fmt.Errorf("dao.GetTokenTransfersSums: %s", err.Error())
+	}
+	sumsMap := make(map[string]dmodels.TokenTransfersSum)
+	for _, s := range sums {
+		sumsMap[s.Token] = s
+	}
+	stats := make([]dmodels.TokenDailyStat, 0, len(tokens))
+	for _, token := range tokens {
+		holders, err := ds.dao.GetESDTAccountsCount(filters.ESDT{TokenIdentifier: token.Identity})
+		if err != nil {
+			return fmt.Errorf("dao.GetESDTAccountsCount: %s", err.Error())
+		}
+		stat := dmodels.TokenDailyStat{
+			Token:     token.Identity,
+			Holders:   holders,
+			Volume:    decimal.Zero,
+			CreatedAt: t,
+		}
+		if s, ok := sumsMap[token.Identity]; ok {
+			stat.Transfers = s.Count
+			stat.Volume = s.Volume
+		}
+		stats = append(stats, stat)
+		err = ds.dao.UpdateTokenSupply(dmodels.TokenSupply{
+			Token:     token.Identity,
+			Supply:    token.Supply,
+			CreatedAt: t.Add(-time.Hour * 24),
+		})
+		if err != nil {
+			return fmt.Errorf("dao.UpdateTokenSupply: %s", err.Error())
+		}
+	}
+	err = ds.dao.CreateTokenDailyStats(stats)
+	if err != nil {
+		return fmt.Errorf("dao.CreateTokenDailyStats: %s", err.Error())
+	}
+	return nil
+}
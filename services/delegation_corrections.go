@@ -0,0 +1,79 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"time"
+)
+
+// reconcileDelegationsBatch is the number of delegators checked in the provider contracts within one run
+const reconcileDelegationsBatch = 500
+
+func (s *ServiceFacade) ReconcileDelegations() {
+	err := s.reconcileDelegations()
+	if err != nil {
+		log.Error("reconcileDelegations: %s", err.Error())
+	}
+}
+
+// reconcileDelegations compares the parsed stake of the least recently checked delegators with the active stake
+// in the provider contracts and records the drift, which is applied to the delegations of the parser
+func (s *ServiceFacade) reconcileDelegations() error {
+	items, err := s.dao.GetDelegationsToReconcile(reconcileDelegationsBatch)
+	if err != nil {
+		return fmt.Errorf("dao.GetDelegationsToReconcile: %s", err.Error())
+	}
+	now := time.Now()
+	for i, item := range items {
+		items[i].CheckedAt = now
+		activeStake, err := s.node.GetUserActiveStake(item.Delegator, item.Provider)
+		if err != nil {
+			// keep the previous drift, the delegator will be checked in the next rounds
+			log.Warn("reconcileDelegations: node.GetUserActiveStake(%s, %s): %s", item.Delegator, item.Provider, err.Error())
+			items[i].OnChain = item.Parsed.Add(item.Drift)
+			continue
+		}
+		onChain := node.ValueToEGLD(activeStake)
+		drift := onChain.Sub(item.Parsed)
+		if delta := drift.Sub(item.Drift); !delta.IsZero() {
+			log.Info("reconcileDelegations: delegator %s, provider %s: drift %s -> %s", item.Delegator, item.Provider, item.Drift, drift)
+			s.parser.CorrectDelegation(item.Delegator, item.Provider, delta)
+		}
+		items[i].OnChain = onChain
+		items[i].Drift = drift
+	}
+	err = s.dao.UpdateDelegationCorrections(items)
+	if err != nil {
+		return fmt.Errorf("dao.UpdateDelegationCorrections: %s", err.Error())
+	}
+	return nil
+}
+
+func (s *ServiceFacade) GetDelegationDrifts(filter filters.DelegationDrifts) (page smodels.Pagination, err error) {
+	corrections, err := s.dao.GetDelegationDrifts(filter)
+	if err != nil {
+		return page, fmt.Errorf("dao.GetDelegationDrifts: %s", err.Error())
+	}
+	total, err := s.dao.GetDelegationDriftsTotal(filter)
+	if err != nil {
+		return page, fmt.Errorf("dao.GetDelegationDriftsTotal: %s", err.Error())
+	}
+	items := make([]smodels.DelegationDrift, len(corrections))
+	for i, c := range corrections {
+		items[i] = smodels.DelegationDrift{
+			Provider:  c.Provider,
+			Delegator: c.Delegator,
+			Parsed:    c.Parsed,
+			OnChain:   c.OnChain,
+			Drift:     c.Drift,
+			CheckedAt: smodels.NewTime(c.CheckedAt),
+		}
+	}
+	return smodels.Pagination{
+		Items: items,
+		Count: total,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/services/es"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+var (
+	// fine buckets are used to compute the gini coefficient, then they are merged into the ranking buckets
+	distributionFineBounds = []decimal.Decimal{
+		decimal.New(1, -18), decimal.New(1, -3), decimal.New(1, -2), decimal.New(1, -1), decimal.New(1, 0),
+		decimal.New(1, 1), decimal.New(1, 2), decimal.New(1, 3), decimal.New(1, 4), decimal.New(1, 5),
+		decimal.New(1, 6), decimal.New(1, 7),
+	}
+	distributionBounds = []decimal.Decimal{
+		decimal.Zero, intToDec(100), intToDec(1000), intToDec(10000), intToDec(100000),
+	}
+	topHolders = []uint64{10, 100, 1000}
+)
+
+func (s *ServiceFacade) GetDistribution() (distribution smodels.Distribution, err error) {
+	err = s.getCache(dmodels.DistributionStorageKey, &distribution)
+	if err != nil {
+		return distribution, fmt.Errorf("getCache: %s", err.Error())
+	}
+	return distribution, nil
+}
+
+func (s *ServiceFacade) UpdateDistribution() {
+	err := s.updateDistribution()
+	if err != nil {
+		log.Error("updateDistribution: %s", err.Error())
+	}
+}
+
+func (s *ServiceFacade) updateDistribution() error {
+	fineBuckets := make([]es.BalanceBucket, len(distributionFineBounds))
+	for i, from := range distributionFineBounds {
+		fineBuckets[i].From = from
+		if i+1 < len(distributionFineBounds) {
+			fineBuckets[i].To = distributionFineBounds[i+1]
+		}
+	}
+	fineBuckets, err := s.dao.GetAccountsDistribution(fineBuckets)
+	if err != nil {
+		return fmt.Errorf("dao.GetAccountsDistribution: %s", err.Error())
+	}
+	distribution := smodels.Distribution{
+		Gini:      giniCoefficient(fineBuckets),
+		UpdatedAt: smodels.NewTime(time.Now()),
+	}
+	distribution.Buckets = make([]smodels.DistributionBucket, len(distributionBounds))
+	for i, from := range distributionBounds {
+		distribution.Buckets[i].From = from
+		if i+1 < len(distributionBounds) {
+			distribution.Buckets[i].To = distributionBounds[i+1]
+		}
+	}
+	for _, fb := range fineBuckets {
+		distribution.Holders += fb.Count
+		distribution.TotalBalance = distribution.TotalBalance.Add(fb.Amount)
+		for i, b := range distribution.Buckets {
+			if fb.From.LessThan(b.From) || (!b.To.IsZero() && (fb.To.IsZero() || fb.To.GreaterThan(b.To))) {
+				continue
+			}
+			distribution.Buckets[i].Count += fb.Count
+			distribution.Buckets[i].Amount = distribution.Buckets[i].Amount.Add(fb.Amount)
+			break
+		}
+	}
+	accounts, err := s.dao.GetAccounts(filters.Accounts{
+		Pagination: filters.Pagination{Limit: topHolders[len(topHolders)-1]},
+		Sort:       filters.AccountsSortByBalanceDesc,
+	})
+	if err != nil {
+		return fmt.Errorf("dao.GetAccounts: %s", err.Error())
+	}
+	for _, top := range topHolders {
+		share := smodels.TopHoldersShare{Top: top}
+		for i := 0; i < len(accounts) && uint64(i) < top; i++ {
+			share.Amount = share.Amount.Add(decimal.NewFromFloat(accounts[i].BalanceNum))
+		}
+		if !distribution.TotalBalance.IsZero() {
+			share.Share = share.Amount.Div(distribution.TotalBalance).Mul(intToDec(100))
+		}
+		distribution.TopShares = append(distribution.TopShares, share)
+	}
+	err = s.setCache(dmodels.DistributionStorageKey, distribution)
+	if err != nil {
+		return fmt.Errorf("setCache: %s", err.Error())
+	}
+	return nil
+}
+
+// giniCoefficient approximates the coefficient by grouped data, buckets must be sorted by balance
+func giniCoefficient(buckets []es.BalanceBucket) decimal.Decimal {
+	var holders uint64
+	total := decimal.Zero
+	for _, b := range buckets {
+		holders += b.Count
+		total = total.Add(b.Amount)
+	}
+	if holders == 0 || total.IsZero() {
+		return decimal.Zero
+	}
+	gini := decimal.New(1, 0)
+	prevShare := decimal.Zero
+	cumulative := decimal.Zero
+	for _, b := range buckets {
+		cumulative = cumulative.Add(b.Amount)
+		share := cumulative.Div(total)
+		populationShare := decimal.New(int64(b.Count), 0).Div(decimal.New(int64(holders), 0))
+		gini = gini.Sub(populationShare.Mul(share.Add(prevShare)))
+		prevShare = share
+	}
+	return gini.Round(4)
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/dao/postgres"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"net/http"
+	"time"
+)
+
+func (s *ServiceFacade) UpdateEpochSnapshot() {
+	err := s.updateEpochSnapshot()
+	if err != nil {
+		log.Error("updateEpochSnapshot: %s", err.Error())
+	}
+}
+
+// updateEpochSnapshot saves the state of the network once the epoch has changed, the next runs within the epoch are ignored
+func (s *ServiceFacade) updateEpochSnapshot() error {
+	status, err := s.node.GetNetworkStatus(node.MetaChainShardIndex)
+	if err != nil {
+		return fmt.Errorf("node.GetNetworkStatus: %s", err.Error())
+	}
+	economics, err := s.node.GetNetworkEconomics()
+	if err != nil {
+		return fmt.Errorf("node.GetNetworkEconomics: %s", err.Error())
+	}
+	statistics, err := s.node.GetValidatorStatistics()
+	if err != nil {
+		return fmt.Errorf("node.GetValidatorStatistics: %s", err.Error())
+	}
+	var validators uint64
+	for _, stat := range statistics {
+		if stat.ValidatorStatus == smodels.NodeStatusEligible {
+			validators++
+		}
+	}
+	err = s.dao.CreateEpochSnapshot(dmodels.EpochSnapshot{
+		Epoch:      status.ErdEpochNumber,
+		Validators: validators,
+		Stake:      economics.ErdTotalBaseStakedValue.Add(economics.ErdTotalTopUpValue),
+		TopUp:      economics.ErdTotalTopUpValue,
+		Shards:     uint64(s.networkConfig.ErdNumShardsWithoutMeta),
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("dao.CreateEpochSnapshot: %s", err.Error())
+	}
+	return nil
+}
+
+func (s *ServiceFacade) GetEpochs(filter filters.Epochs) (items smodels.Pagination, err error) {
+	dEpochs, err := s.dao.GetEpochs(filter)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetEpochs: %s", err.Error())
+	}
+	total, err := s.dao.GetEpochsTotal(filter)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetEpochsTotal: %s", err.Error())
+	}
+	epochs := make([]smodels.EpochSummary, len(dEpochs))
+	for i, e := range dEpochs {
+		epochs[i] = toEpochSummary(e)
+	}
+	return smodels.Pagination{
+		Items: epochs,
+		Count: total,
+	}, nil
+}
+
+func (s *ServiceFacade) GetEpochSummary(epoch uint64) (summary smodels.EpochSummary, err error) {
+	dSummary, err := s.dao.GetEpochSummary(epoch)
+	if err != nil {
+		if err.Error() == postgres.NoRowsError {
+			return summary, smodels.Error{
+				Err:      "not found",
+				Msg:      "epoch not found",
+				HttpCode: http.StatusNotFound,
+			}
+		}
+		return summary, fmt.Errorf("dao.GetEpochSummary: %s", err.Error())
+	}
+	return toEpochSummary(dSummary), nil
+}
+
+func toEpochSummary(e dmodels.EpochSummary) smodels.EpochSummary {
+	return smodels.EpochSummary{
+		Epoch:      e.Epoch.Epoch,
+		StartNonce: e.StartNonce,
+		EndNonce:   e.EndNonce,
+		StartTime:  smodels.NewTime(e.StartTime),
+		EndTime:    smodels.NewTime(e.EndTime),
+		Txs:        e.Txs,
+		Fees:       node.ValueToEGLD(e.Fees),
+		Rewards:    node.ValueToEGLD(e.Rewards),
+		Validators: e.Validators,
+		Stake:      node.ValueToEGLD(e.Stake),
+		TopUp:      node.ValueToEGLD(e.TopUp),
+		Shards:     e.Shards,
+	}
+}
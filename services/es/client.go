@@ -14,6 +14,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 )
 
 type (
@@ -67,6 +68,66 @@ type (
 		Operation      string            `json:"operation"`
 		Tokens         []string          `json:"tokens"`
 		ESDTValues     []decimal.Decimal `json:"esdtValues"`
+		Data           []byte            `json:"data"`
+	}
+	// OperationsCount is the number of the operations of the type within the day
+	OperationsCount struct {
+		Day       time.Time
+		Operation string
+		Count     uint64
+	}
+	// BalanceBucket holds accounts with balance (in EGLD) in range [From, To), zero To means no upper bound
+	BalanceBucket struct {
+		From   decimal.Decimal
+		To     decimal.Decimal
+		Count  uint64
+		Amount decimal.Decimal
+	}
+	rangeAggregationResponse struct {
+		Aggregations struct {
+			Balances struct {
+				Buckets []struct {
+					DocCount uint64 `json:"doc_count"`
+					Amount   struct {
+						Value float64 `json:"value"`
+					} `json:"amount"`
+				} `json:"buckets"`
+			} `json:"balances"`
+		} `json:"aggregations"`
+	}
+	cardinalityAggregationResponse struct {
+		Aggregations struct {
+			Holders struct {
+				Value uint64 `json:"value"`
+			} `json:"holders"`
+		} `json:"aggregations"`
+	}
+	dailyOperationsAggregationResponse struct {
+		Aggregations struct {
+			Days struct {
+				Buckets []struct {
+					Key        int64 `json:"key"`
+					Operations struct {
+						Buckets []struct {
+							Key      string `json:"key"`
+							DocCount uint64 `json:"doc_count"`
+						} `json:"buckets"`
+					} `json:"operations"`
+				} `json:"buckets"`
+			} `json:"days"`
+		} `json:"aggregations"`
+	}
+	collectionsAggregationResponse struct {
+		Aggregations struct {
+			Collections struct {
+				Buckets []struct {
+					Key string `json:"key"`
+				} `json:"buckets"`
+			} `json:"collections"`
+			Total struct {
+				Value uint64 `json:"value"`
+			} `json:"total"`
+		} `json:"aggregations"`
 	}
 	obj map[string]interface{}
 )
@@ -203,7 +264,7 @@ func (c *Client) GetAccount(address string) (acc data.AccountInfo, err error) {
 func (c *Client) GetAccounts(filter filters.Accounts) (accounts []data.AccountInfo, err error) {
 	query := obj{
 		"sort": obj{
-			"balanceNum": obj{"order": "desc"},
+			"balanceNum": obj{"order": filter.SortOrder()},
 		},
 	}
 	if filter.Limit != 0 {
@@ -224,14 +285,10 @@ func (c *Client) GetAccounts(filter filters.Accounts) (accounts []data.AccountIn
 
 func (c *Client) GetESDTAccounts(filter filters.ESDT) (accounts []AccountESDT, err error) {
 	q := esquery.Search().Sort("balanceNum", "desc")
-	query := esquery.Bool()
-	if filter.TokenIdentifier != "" {
-		query.Must(esquery.Match("token", filter.TokenIdentifier))
-	}
-	if len(filter.Address) != 0 {
-		query.Must(esquery.MatchPhrase("address", filter.Address))
+	if filter.NFT {
+		q = esquery.Search().Sort("token", "asc").Sort("tokenNonce", "asc")
 	}
-	q.Query(query)
+	q.Query(esdtAccountsQuery(filter))
 	if filter.Limit != 0 {
 		q = q.Size(filter.Limit)
 	}
@@ -243,6 +300,11 @@ func (c *Client) GetESDTAccounts(filter filters.ESDT) (accounts []AccountESDT, e
 }
 
 func (c *Client) GetESDTAccountsCount(filter filters.ESDT) (total uint64, err error) {
+	total, err = c.count("accountsesdt", esquery.Count(esdtAccountsQuery(filter)))
+	return total, err
+}
+
+func esdtAccountsQuery(filter filters.ESDT) *esquery.BoolQuery {
 	query := esquery.Bool()
 	if filter.TokenIdentifier != "" {
 		query.Must(esquery.Match("token", filter.TokenIdentifier))
@@ -250,8 +312,13 @@ func (c *Client) GetESDTAccountsCount(filter filters.ESDT) (total uint64, err er
 	if len(filter.Address) != 0 {
 		query.Must(esquery.MatchPhrase("address", filter.Address))
 	}
-	total, err = c.count("accountsesdt", esquery.Count(query))
-	return total, err
+	if filter.TokenNonce != 0 {
+		query.Must(esquery.Term("tokenNonce", filter.TokenNonce))
+	}
+	if filter.NFT {
+		query.Must(esquery.Range("tokenNonce").Gt(0))
+	}
+	return query
 }
 
 func (c *Client) GetTokenInfo(id string) (token data.TokenInfo, err error) {
@@ -297,20 +364,7 @@ func (c *Client) GetNFTTokensCount(filter filters.NFTTokens) (total uint64, err
 
 func (c *Client) GetOperations(filter filters.Operations) (operations []Operation, err error) {
 	q := esquery.Search().Sort("timestamp", "desc")
-	query := esquery.Bool()
-	if filter.TxHash != "" {
-		query.Must(esquery.Match("originalTxHash", filter.TxHash))
-	}
-	if filter.Token != "" {
-		query.Must(esquery.MatchPhrase("tokens", filter.Token))
-	}
-	if len(filter.Type) != 0 {
-		for _, t := range filter.Type {
-			query.Should(esquery.MatchPhrase("operation", t))
-		}
-		query.MinimumShouldMatch(1)
-	}
-	q.Query(query)
+	q.Query(operationsQuery(filter))
 	if filter.Limit != 0 {
 		q = q.Size(filter.Limit)
 	}
@@ -327,6 +381,52 @@ func (c *Client) GetOperations(filter filters.Operations) (operations []Operatio
 }
 
 func (c *Client) GetOperationsCount(filter filters.Operations) (total uint64, err error) {
+	total, err = c.count("operations", esquery.Count(operationsQuery(filter)))
+	return total, err
+}
+
+// GetOperationsDailyCounts counts the matched operations by the days (UTC) and the types, the days without operations are skipped
+func (c *Client) GetOperationsDailyCounts(filter filters.Operations) (items []OperationsCount, err error) {
+	types := len(filter.Type)
+	if types == 0 {
+		types = 100
+	}
+	query := obj{
+		"size":  0,
+		"query": operationsQuery(filter).Map(),
+		"aggs": obj{
+			"days": obj{
+				"date_histogram": obj{
+					"field":             "timestamp",
+					"calendar_interval": "1d",
+					"min_doc_count":     1,
+				},
+				"aggs": obj{
+					"operations": obj{
+						"terms": obj{"field": "operation", "size": types},
+					},
+				},
+			},
+		},
+	}
+	var aggResp dailyOperationsAggregationResponse
+	err = c.aggregate("operations", query, &aggResp)
+	if err != nil {
+		return nil, err
+	}
+	for _, day := range aggResp.Aggregations.Days.Buckets {
+		for _, op := range day.Operations.Buckets {
+			items = append(items, OperationsCount{
+				Day:       time.Unix(day.Key/1000, 0).UTC(),
+				Operation: op.Key,
+				Count:     op.DocCount,
+			})
+		}
+	}
+	return items, nil
+}
+
+func operationsQuery(filter filters.Operations) *esquery.BoolQuery {
 	query := esquery.Bool()
 	if filter.TxHash != "" {
 		query.Must(esquery.Match("originalTxHash", filter.TxHash))
@@ -340,8 +440,13 @@ func (c *Client) GetOperationsCount(filter filters.Operations) (total uint64, er
 		}
 		query.MinimumShouldMatch(1)
 	}
-	total, err = c.count("operations", esquery.Count(query))
-	return total, err
+	if !filter.From.IsZero() {
+		query.Must(esquery.Range("timestamp").Gte(filter.From.Unix()))
+	}
+	if !filter.To.IsZero() {
+		query.Must(esquery.Range("timestamp").Lte(filter.To.Unix()))
+	}
+	return query
 }
 
 func (c *Client) GetAccountsCount(filter filters.Accounts) (total uint64, err error) {
@@ -349,6 +454,140 @@ func (c *Client) GetAccountsCount(filter filters.Accounts) (total uint64, err er
 	return total, err
 }
 
+// GetAccountsDistribution counts accounts and sums their balances in the given buckets
+func (c *Client) GetAccountsDistribution(buckets []BalanceBucket) ([]BalanceBucket, error) {
+	ranges := make([]obj, len(buckets))
+	for i, b := range buckets {
+		from, _ := b.From.Float64()
+		r := obj{"from": from}
+		if !b.To.IsZero() {
+			r["to"], _ = b.To.Float64()
+		}
+		ranges[i] = r
+	}
+	query := obj{
+		"size": 0,
+		"aggs": obj{
+			"balances": obj{
+				"range": obj{
+					"field":  "balanceNum",
+					"ranges": ranges,
+				},
+				"aggs": obj{
+					"amount": obj{"sum": obj{"field": "balanceNum"}},
+				},
+			},
+		},
+	}
+	var aggResp rangeAggregationResponse
+	err := c.aggregate("accounts", query, &aggResp)
+	if err != nil {
+		return nil, err
+	}
+	if len(aggResp.Aggregations.Balances.Buckets) != len(buckets) {
+		return nil, fmt.Errorf("wrong number of buckets")
+	}
+	result := make([]BalanceBucket, len(buckets))
+	for i, b := range aggResp.Aggregations.Balances.Buckets {
+		result[i] = buckets[i]
+		result[i].Count = b.DocCount
+		result[i].Amount = decimal.NewFromFloat(b.Amount.Value)
+	}
+	return result, nil
+}
+
+// GetESDTHoldersCount counts unique addresses among the matched ESDT balances
+func (c *Client) GetESDTHoldersCount(filter filters.ESDT) (total uint64, err error) {
+	query := obj{
+		"size":  0,
+		"query": esdtAccountsQuery(filter).Map(),
+		"aggs": obj{
+			"holders": obj{
+				"cardinality": obj{"field": "address"},
+			},
+		},
+	}
+	var aggResp cardinalityAggregationResponse
+	err = c.aggregate("accountsesdt", query, &aggResp)
+	if err != nil {
+		return 0, err
+	}
+	return aggResp.Aggregations.Holders.Value, nil
+}
+
+// GetESDTCollections returns the distinct tokens of the matched balances ordered by the identifier,
+// the terms aggregation can't skip the buckets, so the previous pages are requested and dropped
+func (c *Client) GetESDTCollections(filter filters.ESDT) (collections []string, err error) {
+	limit := filter.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	query := obj{
+		"size":  0,
+		"query": esdtAccountsQuery(filter).Map(),
+		"aggs": obj{
+			"collections": obj{
+				"terms": obj{
+					"field": "token",
+					"size":  filter.Offset() + limit,
+					"order": obj{"_key": "asc"},
+				},
+			},
+		},
+	}
+	var aggResp collectionsAggregationResponse
+	err = c.aggregate("accountsesdt", query, &aggResp)
+	if err != nil {
+		return nil, err
+	}
+	buckets := aggResp.Aggregations.Collections.Buckets
+	for i := filter.Offset(); i < uint64(len(buckets)); i++ {
+		collections = append(collections, buckets[i].Key)
+	}
+	return collections, nil
+}
+
+func (c *Client) GetESDTCollectionsCount(filter filters.ESDT) (total uint64, err error) {
+	query := obj{
+		"size":  0,
+		"query": esdtAccountsQuery(filter).Map(),
+		"aggs": obj{
+			"total": obj{
+				"cardinality": obj{"field": "token"},
+			},
+		},
+	}
+	var aggResp collectionsAggregationResponse
+	err = c.aggregate("accountsesdt", query, &aggResp)
+	if err != nil {
+		return 0, err
+	}
+	return aggResp.Aggregations.Total.Value, nil
+}
+
+func (c *Client) aggregate(index string, query obj, dst interface{}) error {
+	resp, err := c.cli.Search(
+		c.cli.Search.WithIndex(index),
+		c.cli.Search.WithBody(esutil.NewJSONReader(&query)),
+	)
+	if err != nil {
+		return fmt.Errorf("cli.Search: %s", err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		return fmt.Errorf(resp.String())
+	}
+	d, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadAll: %s", err.Error())
+	}
+	err = json.Unmarshal(d, dst)
+	if err != nil {
+		return fmt.Errorf("json.Unmarshal: %s", err.Error())
+	}
+	return nil
+}
+
 func (c *Client) ValidatorsKeys(shard uint64, epoch uint64) (keys data.ValidatorsPublicKeys, err error) {
 	err = c.get("validators", fmt.Sprintf("%d_%d", shard, epoch), &keys)
 	return keys, err
@@ -0,0 +1,554 @@
+package es
+
+import (
+	"fmt"
+	"github.com/ElrondNetwork/elastic-indexer-go/data"
+	"github.com/everstake/elrond-monitor-backend/dao/derrors"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/shopspring/decimal"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultSearchSize mirrors the default "size" of an elasticsearch search request
+const defaultSearchSize = 10
+
+type (
+	// Memory is an in-memory replacement of Client, it keeps the same filters and pagination semantics
+	// as the elasticsearch indexes and is used to run the API and watcher without a cluster.
+	Memory struct {
+		mu             *sync.RWMutex
+		blocks         []data.Block
+		transactions   []data.Transaction
+		miniblocks     map[string]data.Miniblock
+		scResults      []SCResult
+		accounts       []data.AccountInfo
+		esdtAccounts   []AccountESDT
+		operations     []Operation
+		tokens         []data.TokenInfo
+		validatorsKeys map[string]data.ValidatorsPublicKeys
+	}
+)
+
+func NewMemory() *Memory {
+	return &Memory{
+		mu:             &sync.RWMutex{},
+		miniblocks:     make(map[string]data.Miniblock),
+		validatorsKeys: make(map[string]data.ValidatorsPublicKeys),
+	}
+}
+
+func (m *Memory) PutBlock(block data.Block) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, b := range m.blocks {
+		if b.Hash == block.Hash {
+			m.blocks[i] = block
+			return
+		}
+	}
+	m.blocks = append(m.blocks, block)
+}
+
+func (m *Memory) PutTransaction(tx data.Transaction) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, t := range m.transactions {
+		if t.Hash == tx.Hash {
+			m.transactions[i] = tx
+			return
+		}
+	}
+	m.transactions = append(m.transactions, tx)
+}
+
+func (m *Memory) PutMiniblock(miniblock data.Miniblock) {
+	m.mu.Lock()
+	m.miniblocks[miniblock.Hash] = miniblock
+	m.mu.Unlock()
+}
+
+func (m *Memory) PutSCResult(result SCResult) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, r := range m.scResults {
+		if r.Hash == result.Hash {
+			m.scResults[i] = result
+			return
+		}
+	}
+	m.scResults = append(m.scResults, result)
+}
+
+func (m *Memory) PutAccount(account data.AccountInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, a := range m.accounts {
+		if a.Address == account.Address {
+			m.accounts[i] = account
+			return
+		}
+	}
+	m.accounts = append(m.accounts, account)
+}
+
+func (m *Memory) PutESDTAccount(account AccountESDT) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, a := range m.esdtAccounts {
+		if a.Address == account.Address && a.Token == account.Token && a.TokenNonce == account.TokenNonce {
+			m.esdtAccounts[i] = account
+			return
+		}
+	}
+	m.esdtAccounts = append(m.esdtAccounts, account)
+}
+
+func (m *Memory) PutOperation(operation Operation) {
+	m.mu.Lock()
+	m.operations = append(m.operations, operation)
+	m.mu.Unlock()
+}
+
+func (m *Memory) PutTokenInfo(token data.TokenInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := tokenInfoID(token)
+	for i, t := range m.tokens {
+		if tokenInfoID(t) == id {
+			m.tokens[i] = token
+			return
+		}
+	}
+	m.tokens = append(m.tokens, token)
+}
+
+func (m *Memory) PutValidatorsKeys(shard uint64, epoch uint64, keys data.ValidatorsPublicKeys) {
+	m.mu.Lock()
+	m.validatorsKeys[fmt.Sprintf("%d_%d", shard, epoch)] = keys
+	m.mu.Unlock()
+}
+
+func (m *Memory) GetBlock(hash string) (block data.Block, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, b := range m.blocks {
+		if b.Hash == hash {
+			return b, nil
+		}
+	}
+	return block, derrors.NotFound
+}
+
+func (m *Memory) GetBlocks(filter filters.Blocks) (blocks []data.Block, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, b := range m.blocks {
+		if filter.Nonce != 0 && len(filter.Shard) != 0 {
+			if b.Nonce != filter.Nonce || uint64(b.ShardID) != filter.Shard[0] {
+				continue
+			}
+		}
+		blocks = append(blocks, b)
+	}
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].Timestamp > blocks[j].Timestamp
+	})
+	from, to := page(len(blocks), filter.Pagination)
+	return blocks[from:to], nil
+}
+
+func (m *Memory) GetBlocksCount(filter filters.Blocks) (total uint64, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, b := range m.blocks {
+		if filter.Nonce != 0 && b.Nonce != filter.Nonce {
+			continue
+		}
+		if len(filter.Shard) > 0 && uint64(b.ShardID) != filter.Shard[0] {
+			continue
+		}
+		total++
+	}
+	return total, nil
+}
+
+func (m *Memory) GetTransaction(hash string) (tx Tx, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, t := range m.transactions {
+		if t.Hash == hash {
+			return Tx{Transaction: t}, nil
+		}
+	}
+	return tx, derrors.NotFound
+}
+
+func (m *Memory) GetTransactions(filter filters.Transactions) (txs []data.Transaction, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, tx := range m.transactions {
+		if matchTransaction(tx, filter) {
+			txs = append(txs, tx)
+		}
+	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].Timestamp > txs[j].Timestamp
+	})
+	from, to := page(len(txs), filter.Pagination)
+	return txs[from:to], nil
+}
+
+func (m *Memory) GetTransactionsCount(filter filters.Transactions) (total uint64, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, tx := range m.transactions {
+		if matchTransaction(tx, filter) {
+			total++
+		}
+	}
+	return total, nil
+}
+
+func (m *Memory) GetMiniblock(hash string) (miniblock data.Miniblock, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	miniblock, ok := m.miniblocks[hash]
+	if !ok {
+		return miniblock, derrors.NotFound
+	}
+	return miniblock, nil
+}
+
+func (m *Memory) GetSCResults(txHash string) (results []SCResult, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, r := range m.scResults {
+		if r.OriginalTxHash == txHash {
+			results = append(results, r)
+		}
+	}
+	if len(results) > defaultSearchSize {
+		results = results[:defaultSearchSize]
+	}
+	return results, nil
+}
+
+func (m *Memory) ValidatorsKeys(shard uint64, epoch uint64) (keys data.ValidatorsPublicKeys, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys, ok := m.validatorsKeys[fmt.Sprintf("%d_%d", shard, epoch)]
+	if !ok {
+		return keys, derrors.NotFound
+	}
+	return keys, nil
+}
+
+func (m *Memory) GetAccount(address string) (acc data.AccountInfo, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, a := range m.accounts {
+		if a.Address == address {
+			return a, nil
+		}
+	}
+	return acc, derrors.NotFound
+}
+
+func (m *Memory) GetAccounts(filter filters.Accounts) (accounts []data.AccountInfo, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	accounts = append(accounts, m.accounts...)
+	sort.SliceStable(accounts, func(i, j int) bool {
+		if filter.Sort == filters.AccountsSortByBalanceAsc {
+			return accounts[i].BalanceNum < accounts[j].BalanceNum
+		}
+		return accounts[i].BalanceNum > accounts[j].BalanceNum
+	})
+	from, to := page(len(accounts), filter.Pagination)
+	return accounts[from:to], nil
+}
+
+func (m *Memory) GetAccountsCount(filter filters.Accounts) (total uint64, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return uint64(len(m.accounts)), nil
+}
+
+func (m *Memory) GetAccountsDistribution(buckets []BalanceBucket) ([]BalanceBucket, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	result := make([]BalanceBucket, len(buckets))
+	copy(result, buckets)
+	for _, a := range m.accounts {
+		balance := decimal.NewFromFloat(a.BalanceNum)
+		for i, b := range result {
+			if balance.LessThan(b.From) || (!b.To.IsZero() && balance.GreaterThanOrEqual(b.To)) {
+				continue
+			}
+			result[i].Count++
+			result[i].Amount = result[i].Amount.Add(balance)
+		}
+	}
+	return result, nil
+}
+
+func (m *Memory) GetESDTAccounts(filter filters.ESDT) (accounts []AccountESDT, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, a := range m.esdtAccounts {
+		if matchESDTAccount(a, filter) {
+			accounts = append(accounts, a)
+		}
+	}
+	sort.SliceStable(accounts, func(i, j int) bool {
+		if filter.NFT {
+			if accounts[i].Token != accounts[j].Token {
+				return accounts[i].Token < accounts[j].Token
+			}
+			return accounts[i].TokenNonce < accounts[j].TokenNonce
+		}
+		return accounts[i].BalanceNum.GreaterThan(accounts[j].BalanceNum)
+	})
+	from, to := page(len(accounts), filter.Pagination)
+	return accounts[from:to], nil
+}
+
+func (m *Memory) GetESDTAccountsCount(filter filters.ESDT) (total uint64, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, a := range m.esdtAccounts {
+		if matchESDTAccount(a, filter) {
+			total++
+		}
+	}
+	return total, nil
+}
+
+func (m *Memory) GetESDTHoldersCount(filter filters.ESDT) (total uint64, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	holders := make(map[string]struct{})
+	for _, a := range m.esdtAccounts {
+		if matchESDTAccount(a, filter) {
+			holders[a.Address] = struct{}{}
+		}
+	}
+	return uint64(len(holders)), nil
+}
+
+func (m *Memory) GetESDTCollections(filter filters.ESDT) (collections []string, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	unique := make(map[string]struct{})
+	for _, a := range m.esdtAccounts {
+		if _, ok := unique[a.Token]; !ok && matchESDTAccount(a, filter) {
+			unique[a.Token] = struct{}{}
+			collections = append(collections, a.Token)
+		}
+	}
+	sort.Strings(collections)
+	from, to := page(len(collections), filter.Pagination)
+	return collections[from:to], nil
+}
+
+func (m *Memory) GetESDTCollectionsCount(filter filters.ESDT) (total uint64, err error) {
+	collections := make(map[string]struct{})
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, a := range m.esdtAccounts {
+		if matchESDTAccount(a, filter) {
+			collections[a.Token] = struct{}{}
+		}
+	}
+	return uint64(len(collections)), nil
+}
+
+func (m *Memory) GetOperations(filter filters.Operations) (operations []Operation, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, op := range m.operations {
+		if matchOperation(op, filter) {
+			operations = append(operations, op)
+		}
+	}
+	sort.SliceStable(operations, func(i, j int) bool {
+		return operations[i].Timestamp > operations[j].Timestamp
+	})
+	from, to := page(len(operations), filter.Pagination)
+	return operations[from:to], nil
+}
+
+func (m *Memory) GetOperationsCount(filter filters.Operations) (total uint64, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, op := range m.operations {
+		if matchOperation(op, filter) {
+			total++
+		}
+	}
+	return total, nil
+}
+
+func (m *Memory) GetOperationsDailyCounts(filter filters.Operations) (items []OperationsCount, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	counts := make(map[OperationsCount]uint64)
+	for _, op := range m.operations {
+		if matchOperation(op, filter) {
+			day := time.Unix(int64(op.Timestamp), 0).UTC().Truncate(time.Hour * 24)
+			counts[OperationsCount{Day: day, Operation: op.Operation}]++
+		}
+	}
+	for key, count := range counts {
+		key.Count = count
+		items = append(items, key)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Day.Equal(items[j].Day) {
+			return items[i].Operation < items[j].Operation
+		}
+		return items[i].Day.Before(items[j].Day)
+	})
+	return items, nil
+}
+
+func (m *Memory) GetTokenInfo(id string) (token data.TokenInfo, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, t := range m.tokens {
+		if tokenInfoID(t) == id {
+			return t, nil
+		}
+	}
+	return token, derrors.NotFound
+}
+
+func (m *Memory) GetNFTTokens(filter filters.NFTTokens) (tokens []data.TokenInfo, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, t := range m.tokens {
+		if matchPhrase(t.Identifier, filter.Collection) {
+			tokens = append(tokens, t)
+		}
+	}
+	sort.SliceStable(tokens, func(i, j int) bool {
+		return tokens[i].Timestamp > tokens[j].Timestamp
+	})
+	from, to := page(len(tokens), filter.Pagination)
+	return tokens[from:to], nil
+}
+
+func (m *Memory) GetNFTTokensCount(filter filters.NFTTokens) (total uint64, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, t := range m.tokens {
+		if matchPhrase(t.Identifier, filter.Collection) {
+			total++
+		}
+	}
+	return total, nil
+}
+
+func matchTransaction(tx data.Transaction, filter filters.Transactions) bool {
+	if filter.Address != "" && tx.Sender != filter.Address && tx.Receiver != filter.Address {
+		return false
+	}
+	if filter.MiniBlock != "" && tx.MBHash != filter.MiniBlock {
+		return false
+	}
+	return true
+}
+
+func matchESDTAccount(account AccountESDT, filter filters.ESDT) bool {
+	if filter.TokenIdentifier != "" && account.Token != filter.TokenIdentifier {
+		return false
+	}
+	if filter.Address != "" && account.Address != filter.Address {
+		return false
+	}
+	if filter.TokenNonce != 0 && account.TokenNonce != filter.TokenNonce {
+		return false
+	}
+	if filter.NFT && account.TokenNonce == 0 {
+		return false
+	}
+	return true
+}
+
+func matchOperation(op Operation, filter filters.Operations) bool {
+	if filter.TxHash != "" && op.OriginalTxHash != filter.TxHash {
+		return false
+	}
+	if !filter.From.IsZero() && op.Timestamp < uint64(filter.From.Unix()) {
+		return false
+	}
+	if !filter.To.IsZero() && op.Timestamp > uint64(filter.To.Unix()) {
+		return false
+	}
+	if filter.Token != "" {
+		found := false
+		for _, t := range op.Tokens {
+			if matchPhrase(t, filter.Token) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(filter.Type) != 0 {
+		found := false
+		for _, t := range filter.Type {
+			if op.Operation == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// matchPhrase emulates match_phrase on a standard analyzed field, where identifiers are split by "-"
+func matchPhrase(value string, phrase string) bool {
+	if phrase == "" {
+		return true
+	}
+	value, phrase = strings.ToLower(value), strings.ToLower(phrase)
+	if value == phrase {
+		return true
+	}
+	return strings.HasPrefix(value, phrase+"-") ||
+		strings.HasSuffix(value, "-"+phrase) ||
+		strings.Contains(value, "-"+phrase+"-")
+}
+
+func tokenInfoID(token data.TokenInfo) string {
+	if token.Identifier != "" {
+		return token.Identifier
+	}
+	return token.Token
+}
+
+// page returns the bounds of the requested page, an empty limit is the elasticsearch default size
+func page(length int, pagination filters.Pagination) (from int, to int) {
+	limit := int(pagination.Limit)
+	if limit == 0 {
+		limit = defaultSearchSize
+	}
+	from = int(pagination.Offset())
+	if from > length {
+		from = length
+	}
+	to = from + limit
+	if to > length {
+		to = length
+	}
+	return from, to
+}
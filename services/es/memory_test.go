@@ -0,0 +1,81 @@
+package es
+
+import (
+	"github.com/ElrondNetwork/elastic-indexer-go/data"
+	"github.com/everstake/elrond-monitor-backend/dao/derrors"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/shopspring/decimal"
+	"testing"
+	"time"
+)
+
+func TestMemoryTransactions(t *testing.T) {
+	m := NewMemory()
+	for i, h := range []string{"a", "b", "c", "d"} {
+		m.PutTransaction(data.Transaction{
+			Hash:      h,
+			Sender:    "erd1sender",
+			Receiver:  h,
+			MBHash:    "mb",
+			Timestamp: time.Duration(100 + i),
+		})
+	}
+	txs, err := m.GetTransactions(filters.Transactions{Pagination: filters.Pagination{Limit: 2, Page: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txs) != 2 || txs[0].Hash != "b" || txs[1].Hash != "a" {
+		t.Error("wrong page", txs)
+	}
+	total, _ := m.GetTransactionsCount(filters.Transactions{Address: "c"})
+	if total != 1 {
+		t.Error("wrong total by address", total)
+	}
+	_, err = m.GetTransaction("x")
+	if err != derrors.NotFound {
+		t.Error("expected not found", err)
+	}
+}
+
+func TestMemoryOperations(t *testing.T) {
+	m := NewMemory()
+	m.PutOperation(Operation{OriginalTxHash: "1", Operation: "ESDTTransfer", Tokens: []string{"MEX-455c57"}, Timestamp: 1})
+	m.PutOperation(Operation{OriginalTxHash: "2", Operation: "ESDTNFTTransfer", Tokens: []string{"COL-a1b2c3-01"}, Timestamp: 2})
+	m.PutOperation(Operation{OriginalTxHash: "3", Operation: "ESDTTransfer", Tokens: []string{"RIDE-7d18e9"}, Timestamp: 3})
+	ops, _ := m.GetOperations(filters.Operations{Type: []string{"ESDTTransfer"}})
+	if len(ops) != 2 || ops[0].OriginalTxHash != "3" {
+		t.Error("wrong operations by type", ops)
+	}
+	total, _ := m.GetOperationsCount(filters.Operations{Token: "COL-a1b2c3"})
+	if total != 1 {
+		t.Error("wrong total by collection", total)
+	}
+	m.PutOperation(Operation{OriginalTxHash: "4", Operation: "ESDTNFTTransfer", Tokens: []string{"COL-a1b2c3-02"}, Timestamp: 86401})
+	counts, _ := m.GetOperationsDailyCounts(filters.Operations{Token: "COL-a1b2c3"})
+	if len(counts) != 2 || counts[0].Count != 1 || counts[1].Day.Unix() != 86400 {
+		t.Error("wrong daily counts", counts)
+	}
+}
+
+func TestMemoryESDTAccounts(t *testing.T) {
+	m := NewMemory()
+	m.PutESDTAccount(AccountESDT{Address: "a", Token: "MEX-455c57", BalanceNum: decimal.New(5, 0)})
+	m.PutESDTAccount(AccountESDT{Address: "b", Token: "MEX-455c57", BalanceNum: decimal.New(10, 0)})
+	m.PutESDTAccount(AccountESDT{Address: "b", Token: "RIDE-7d18e9", BalanceNum: decimal.New(1, 0)})
+	accounts, _ := m.GetESDTAccounts(filters.ESDT{TokenIdentifier: "MEX-455c57"})
+	if len(accounts) != 2 || accounts[0].Address != "b" {
+		t.Error("wrong accounts order", accounts)
+	}
+	m.PutESDTAccount(AccountESDT{Address: "b", Token: "APES-1a2b3c", TokenNonce: 2, BalanceNum: decimal.New(1, 0)})
+	m.PutESDTAccount(AccountESDT{Address: "b", Token: "APES-1a2b3c", TokenNonce: 1, BalanceNum: decimal.New(1, 0)})
+	nfts, _ := m.GetESDTAccounts(filters.ESDT{Address: "b", NFT: true})
+	if len(nfts) != 2 || nfts[0].TokenNonce != 1 {
+		t.Error("wrong nfts", nfts)
+	}
+	m.PutESDTAccount(AccountESDT{Address: "b", Token: "ART-4d5e6f", TokenNonce: 1, BalanceNum: decimal.New(1, 0)})
+	collections, _ := m.GetESDTCollections(filters.ESDT{Address: "b", NFT: true, Pagination: filters.Pagination{Limit: 1, Page: 2}})
+	total, _ := m.GetESDTCollectionsCount(filters.ESDT{Address: "b", NFT: true})
+	if len(collections) != 1 || collections[0] != "ART-4d5e6f" || total != 2 {
+		t.Error("wrong collections", collections, total)
+	}
+}
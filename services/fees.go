@@ -0,0 +1,27 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/services/dailystats"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"strings"
+)
+
+// GetTopFeeContracts returns the smart contracts with the highest fees paid for their calls within the range
+func (s *ServiceFacade) GetTopFeeContracts(filter filters.DailyStats) (items []smodels.ContractFees, err error) {
+	prefix := fmt.Sprintf(dailystats.ContractFeesKey, "")
+	filter.Key = prefix
+	sums, err := s.dao.GetDailyStatsSums(filter)
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetDailyStatsSums: %s", err.Error())
+	}
+	items = make([]smodels.ContractFees, len(sums))
+	for i, sum := range sums {
+		items[i] = smodels.ContractFees{
+			Contract: strings.TrimPrefix(sum.Title, prefix),
+			Fees:     sum.Value,
+		}
+	}
+	return items, nil
+}
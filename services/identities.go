@@ -0,0 +1,126 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/postgres"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/services/elrondapi"
+	"github.com/everstake/elrond-monitor-backend/services/identity"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"net/http"
+	"time"
+)
+
+const (
+	identitiesRefreshInterval = time.Hour
+	maxIdentityLength         = 192
+	maxIdentityNameLength     = 255
+)
+
+// newIdentityResolver makes the resolver with the remote sources in the order of priority: Elrond API, Keybase,
+// the local file is checked before the cache, so it isn't part of the resolver
+func newIdentityResolver() *identity.Resolver {
+	return identity.NewResolver(
+		identity.NewElrondAPISource(elrondapi.NewAPI(), identitiesRefreshInterval),
+		identity.NewKeybaseSource(),
+	)
+}
+
+// getIdentityProfile returns the overridden profile, the profile from the local file or the cached profile,
+// the expired profile is resolved again, the stale cache is used when the sources are unavailable
+func (s *ServiceFacade) getIdentityProfile(key string) (profile dmodels.IdentityProfile, err error) {
+	cached, err := s.dao.GetIdentityProfile(key)
+	if err != nil && err.Error() != postgres.NoRowsError {
+		return profile, fmt.Errorf("dao.GetIdentityProfile: %s", err.Error())
+	}
+	found := err == nil
+	if found && cached.Override {
+		return cached, nil
+	}
+	p, ok, err := s.identitiesFile.Profile(key)
+	if err != nil {
+		log.Warn("getIdentityProfile: identitiesFile.Profile(%s): %s", key, err.Error())
+	}
+	if ok && !p.IsEmpty() {
+		return dmodels.IdentityProfile{
+			Identity:    key,
+			Name:        p.Name,
+			Avatar:      p.Avatar,
+			Description: p.Description,
+			Website:     p.Website,
+			Source:      s.identitiesFile.Name(),
+			UpdatedAt:   time.Now(),
+		}, nil
+	}
+	ttl := time.Duration(s.cfg.Identities.CacheTTL) * time.Hour
+	if found && time.Since(cached.UpdatedAt) < ttl {
+		return cached, nil
+	}
+	p, source, err := s.identities.Resolve(key)
+	if err != nil {
+		if found {
+			log.Warn("getIdentityProfile: identities.Resolve(%s): %s", key, err.Error())
+			return cached, nil
+		}
+		return profile, fmt.Errorf("identities.Resolve: %s", err.Error())
+	}
+	profile = dmodels.IdentityProfile{
+		Identity:    key,
+		Name:        p.Name,
+		Avatar:      p.Avatar,
+		Description: p.Description,
+		Website:     p.Website,
+		Source:      source,
+		UpdatedAt:   time.Now(),
+	}
+	// the unknown identity is cached too, so the sources are not requested until the cache expires
+	err = s.dao.UpdateIdentityProfile(profile)
+	if err != nil {
+		return profile, fmt.Errorf("dao.UpdateIdentityProfile: %s", err.Error())
+	}
+	return profile, nil
+}
+
+// SetIdentityProfile overrides the profile of the identity until the override is deleted
+func (s *ServiceFacade) SetIdentityProfile(key string, profile smodels.IdentityProfile) error {
+	if key == "" || len(key) > maxIdentityLength {
+		return smodels.Error{
+			Err:      "bad_request",
+			Msg:      "invalid identity",
+			HttpCode: http.StatusBadRequest,
+		}
+	}
+	if len(profile.Name) > maxIdentityNameLength {
+		return smodels.Error{
+			Err:      "bad_request",
+			Msg:      fmt.Sprintf("name is longer than %d", maxIdentityNameLength),
+			HttpCode: http.StatusBadRequest,
+		}
+	}
+	err := s.dao.UpdateIdentityProfile(dmodels.IdentityProfile{
+		Identity:    key,
+		Name:        profile.Name,
+		Avatar:      profile.Avatar,
+		Description: profile.Description,
+		Website:     profile.Website,
+		Source:      dmodels.IdentityProfileOverrideSource,
+		Override:    true,
+		UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("dao.UpdateIdentityProfile: %s", err.Error())
+	}
+	go s.UpdateValidators()
+	return nil
+}
+
+// DeleteIdentityProfile removes the override, the profile is resolved from the sources again
+func (s *ServiceFacade) DeleteIdentityProfile(key string) error {
+	err := s.dao.DeleteIdentityProfileOverride(key)
+	if err != nil {
+		return fmt.Errorf("dao.DeleteIdentityProfileOverride: %s", err.Error())
+	}
+	go s.UpdateValidators()
+	return nil
+}
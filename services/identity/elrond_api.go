@@ -0,0 +1,68 @@
+package identity
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/services/elrondapi"
+	"sync"
+	"time"
+)
+
+const ElrondAPISourceName = "elrond_api"
+
+const minElrondAPIBackoff = time.Minute
+
+// ElrondAPISource uses the list of the identities from the Elrond API, the list is requested once per refresh interval,
+// the previous list is used while the API is unavailable, the retries are delayed by the doubling backoff
+type ElrondAPISource struct {
+	api       elrondapi.APIi
+	refresh   time.Duration
+	mu        sync.Mutex
+	updatedAt time.Time
+	retryAt   time.Time
+	backoff   time.Duration
+	profiles  map[string]Profile
+}
+
+func NewElrondAPISource(api elrondapi.APIi, refresh time.Duration) *ElrondAPISource {
+	return &ElrondAPISource{api: api, refresh: refresh}
+}
+
+func (s *ElrondAPISource) Name() string {
+	return ElrondAPISourceName
+}
+
+func (s *ElrondAPISource) Profile(identity string) (profile Profile, ok bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Since(s.updatedAt) > s.refresh && time.Now().After(s.retryAt) {
+		identities, err := s.api.GetIdentities()
+		if err != nil {
+			s.backoff *= 2
+			if s.backoff > s.refresh {
+				s.backoff = s.refresh
+			}
+			if s.backoff < minElrondAPIBackoff {
+				s.backoff = minElrondAPIBackoff
+			}
+			s.retryAt = time.Now().Add(s.backoff)
+			if s.profiles == nil {
+				return profile, false, fmt.Errorf("api.GetIdentities: %s", err.Error())
+			}
+			profile, ok = s.profiles[identity]
+			return profile, ok, nil
+		}
+		s.backoff = 0
+		profiles := make(map[string]Profile, len(identities))
+		for _, i := range identities {
+			profiles[i.Identity] = Profile{
+				Name:        i.Name,
+				Avatar:      i.Avatar,
+				Description: i.Description,
+			}
+		}
+		s.profiles = profiles
+		s.updatedAt = time.Now()
+	}
+	profile, ok = s.profiles[identity]
+	return profile, ok, nil
+}
@@ -0,0 +1,68 @@
+package identity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+const FileSourceName = "file"
+
+// FileSource reads the profiles from the local JSON file (identity => profile), the file is reloaded after the change
+type FileSource struct {
+	path     string
+	mu       sync.Mutex
+	modTime  time.Time
+	profiles map[string]Profile
+}
+
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+func (s *FileSource) Name() string {
+	return FileSourceName
+}
+
+func (s *FileSource) Profile(identity string) (profile Profile, ok bool, err error) {
+	if s.path == "" {
+		return profile, false, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err = s.load()
+	if err != nil {
+		return profile, false, fmt.Errorf("load: %s", err.Error())
+	}
+	profile, ok = s.profiles[identity]
+	return profile, ok, nil
+}
+
+func (s *FileSource) load() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			s.profiles = nil
+			return nil
+		}
+		return fmt.Errorf("os.Stat: %s", err.Error())
+	}
+	if info.ModTime().Equal(s.modTime) && s.profiles != nil {
+		return nil
+	}
+	data, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadFile: %s", err.Error())
+	}
+	profiles := make(map[string]Profile)
+	err = json.Unmarshal(data, &profiles)
+	if err != nil {
+		return fmt.Errorf("json.Unmarshal: %s", err.Error())
+	}
+	s.profiles = profiles
+	s.modTime = info.ModTime()
+	return nil
+}
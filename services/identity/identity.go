@@ -0,0 +1,52 @@
+package identity
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	// Profile is a public profile of the validator identity
+	Profile struct {
+		Name        string `json:"name"`
+		Avatar      string `json:"avatar"`
+		Description string `json:"description"`
+		Website     string `json:"website"`
+	}
+	// Source looks up the profile of the identity, ok is false when the source doesn't know the identity
+	Source interface {
+		Name() string
+		Profile(identity string) (profile Profile, ok bool, err error)
+	}
+	// Resolver asks the sources in the order of priority and returns the first found profile
+	Resolver struct {
+		sources []Source
+	}
+)
+
+func NewResolver(sources ...Source) *Resolver {
+	return &Resolver{sources: sources}
+}
+
+// Resolve returns the profile and the name of the source, the errors of the sources are returned only if no one has found the profile
+func (r *Resolver) Resolve(identity string) (profile Profile, source string, err error) {
+	var errs []string
+	for _, src := range r.sources {
+		p, ok, err := src.Profile(identity)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", src.Name(), err.Error()))
+			continue
+		}
+		if ok && !p.IsEmpty() {
+			return p, src.Name(), nil
+		}
+	}
+	if len(errs) != 0 {
+		return profile, "", fmt.Errorf(strings.Join(errs, "; "))
+	}
+	return profile, "", nil
+}
+
+func (p Profile) IsEmpty() bool {
+	return p.Name == "" && p.Avatar == "" && p.Description == "" && p.Website == ""
+}
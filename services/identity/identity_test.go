@@ -0,0 +1,97 @@
+package identity
+
+import (
+	"errors"
+	"github.com/everstake/elrond-monitor-backend/services/elrondapi"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type staticSource struct {
+	name     string
+	profiles map[string]Profile
+	err      error
+}
+
+func (s staticSource) Name() string {
+	return s.name
+}
+
+func (s staticSource) Profile(identity string) (Profile, bool, error) {
+	p, ok := s.profiles[identity]
+	return p, ok, s.err
+}
+
+func TestResolve(t *testing.T) {
+	r := NewResolver(
+		staticSource{name: "broken", err: errors.New("unavailable")},
+		staticSource{name: "first", profiles: map[string]Profile{"a": {Name: "A"}, "b": {}}},
+		staticSource{name: "second", profiles: map[string]Profile{"a": {Name: "A2"}, "b": {Name: "B"}}},
+	)
+	p, src, err := r.Resolve("a")
+	if err != nil || src != "first" || p.Name != "A" {
+		t.Error("wrong profile", p, src, err)
+	}
+	p, src, err = r.Resolve("b")
+	if err != nil || src != "second" || p.Name != "B" {
+		t.Error("empty profile is not skipped", p, src, err)
+	}
+	_, _, err = r.Resolve("c")
+	if err == nil {
+		t.Error("error of the source is lost")
+	}
+}
+
+func TestFileSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "identity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "identities.json")
+	s := NewFileSource(path)
+	_, ok, err := s.Profile("a")
+	if err != nil || ok {
+		t.Error("missing file must be ignored", ok, err)
+	}
+	err = ioutil.WriteFile(path, []byte(`{"a": {"name": "A", "website": "https://a.test"}}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, ok, err := s.Profile("a")
+	if err != nil || !ok || p.Name != "A" || p.Website != "https://a.test" {
+		t.Error("wrong profile", p, ok, err)
+	}
+}
+
+type identitiesAPI struct {
+	elrondapi.APIi
+	calls      int
+	identities []elrondapi.Identity
+	err        error
+}
+
+func (a *identitiesAPI) GetIdentities() ([]elrondapi.Identity, error) {
+	a.calls++
+	return a.identities, a.err
+}
+
+func TestElrondAPISourceStale(t *testing.T) {
+	api := &identitiesAPI{identities: []elrondapi.Identity{{Identity: "a", Name: "A"}}}
+	s := NewElrondAPISource(api, 0)
+	p, ok, err := s.Profile("a")
+	if err != nil || !ok || p.Name != "A" {
+		t.Fatal("wrong profile", p, ok, err)
+	}
+	api.err = errors.New("unavailable")
+	p, ok, err = s.Profile("a")
+	if err != nil || !ok || p.Name != "A" {
+		t.Error("stale profile is lost", p, ok, err)
+	}
+	_, _, _ = s.Profile("a")
+	if api.calls != 2 {
+		t.Error("failed request is not delayed", api.calls)
+	}
+}
@@ -0,0 +1,79 @@
+package identity
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	KeybaseSourceName = "keybase"
+
+	keybaseLookupURL = "https://keybase.io/_/api/1.0/user/lookup.json?username=%s"
+	keybaseStatusOK  = 0
+)
+
+type (
+	// KeybaseSource looks up the identity as the Keybase username
+	KeybaseSource struct {
+		client *http.Client
+	}
+	keybaseLookup struct {
+		Status struct {
+			Code int    `json:"code"`
+			Name string `json:"name"`
+		} `json:"status"`
+		Them *struct {
+			Profile struct {
+				FullName string `json:"full_name"`
+				Bio      string `json:"bio"`
+			} `json:"profile"`
+			Pictures struct {
+				Primary struct {
+					URL string `json:"url"`
+				} `json:"primary"`
+			} `json:"pictures"`
+		} `json:"them"`
+	}
+)
+
+func NewKeybaseSource() *KeybaseSource {
+	return &KeybaseSource{
+		client: &http.Client{
+			Timeout: time.Second * 30,
+		},
+	}
+}
+
+func (s *KeybaseSource) Name() string {
+	return KeybaseSourceName
+}
+
+func (s *KeybaseSource) Profile(identity string) (profile Profile, ok bool, err error) {
+	resp, err := s.client.Get(fmt.Sprintf(keybaseLookupURL, url.QueryEscape(identity)))
+	if err != nil {
+		return profile, false, fmt.Errorf("client.Get: %s", err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return profile, false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return profile, false, fmt.Errorf("status code: %d", resp.StatusCode)
+	}
+	var data keybaseLookup
+	err = json.NewDecoder(resp.Body).Decode(&data)
+	if err != nil {
+		return profile, false, fmt.Errorf("json.Decode: %s", err.Error())
+	}
+	if data.Status.Code != keybaseStatusOK || data.Them == nil {
+		return profile, false, nil
+	}
+	return Profile{
+		Name:        data.Them.Profile.FullName,
+		Avatar:      data.Them.Pictures.Primary.URL,
+		Description: data.Them.Profile.Bio,
+	}, true, nil
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"time"
+)
+
+const nftCreateRole = "ESDTRoleNFTCreate"
+
+var nftTransferOperations = []string{dmodels.ESDTNFTTransferOperation, dmodels.MultiESDTNFTTransferOperation}
+
+func (s *ServiceFacade) UpdateNFTCollectionsStats() {
+	tn := time.Now()
+	collections, err := s.dao.GetNFTCollections(filters.NFTCollections{})
+	if err != nil {
+		log.Error("UpdateNFTCollectionsStats: dao.GetNFTCollections: %s", err.Error())
+		return
+	}
+	for _, collection := range collections {
+		stats, err := s.makeNFTCollectionStats(collection.Identity)
+		if err != nil {
+			log.Error("UpdateNFTCollectionsStats: makeNFTCollectionStats(%s): %s", collection.Identity, err.Error())
+			continue
+		}
+		err = s.dao.UpdateNFTCollectionStats(collection.Identity, stats)
+		if err != nil {
+			log.Error("UpdateNFTCollectionsStats: dao.UpdateNFTCollectionStats: %s", err.Error())
+		}
+	}
+	log.Debug("UpdateNFTCollectionsStats: complete. duration: %s", time.Now().Sub(tn))
+}
+
+func (s *ServiceFacade) makeNFTCollectionStats(identity string) (stats dmodels.NFTCollectionStats, err error) {
+	now := time.Now()
+	dayAgo := smodels.NewTime(now.Add(-time.Hour * 24))
+	stats.StatsUpdatedAt = now
+	stats.Items, err = s.dao.GetNFTTokensCount(filters.NFTTokens{Collection: identity})
+	if err != nil {
+		return stats, fmt.Errorf("dao.GetNFTTokensCount: %s", err.Error())
+	}
+	stats.Holders, err = s.dao.GetESDTHoldersCount(filters.ESDT{TokenIdentifier: identity, NFT: true})
+	if err != nil {
+		return stats, fmt.Errorf("dao.GetESDTHoldersCount: %s", err.Error())
+	}
+	stats.MintsPerDay, err = s.dao.GetOperationsCount(filters.Operations{
+		Token: identity,
+		Type:  []string{dmodels.ESDTNFTCreateOperation},
+		From:  dayAgo,
+	})
+	if err != nil {
+		return stats, fmt.Errorf("dao.GetOperationsCount: %s", err.Error())
+	}
+	stats.TransfersPerDay, err = s.dao.GetOperationsCount(filters.Operations{
+		Token: identity,
+		Type:  nftTransferOperations,
+		From:  dayAgo,
+	})
+	if err != nil {
+		return stats, fmt.Errorf("dao.GetOperationsCount: %s", err.Error())
+	}
+	roles, err := s.node.GetESDTAllAddressesAndRoles(identity)
+	if err != nil {
+		return stats, fmt.Errorf("node.GetESDTAllAddressesAndRoles: %s", err.Error())
+	}
+	creators := make([]string, 0)
+	for _, r := range roles {
+		for _, role := range r.Roles {
+			if role == nftCreateRole {
+				creators = append(creators, r.Address)
+				break
+			}
+		}
+	}
+	stats.Creators, _ = json.Marshal(creators)
+	return stats, nil
+}
+
+// GetNFTCollectionStats returns the periodically computed stats with the mints and transfers of every day of the filter range
+func (s *ServiceFacade) GetNFTCollectionStats(filter filters.NFTCollectionStats) (stats smodels.NFTCollectionStats, err error) {
+	collection, err := s.dao.GetNFTCollection(filter.Identifier)
+	if err != nil {
+		return stats, fmt.Errorf("dao.GetNFTCollection: %s", err.Error())
+	}
+	filter.From = smodels.NewTime(truncateTime(filter.From.Time, filters.DayInterval))
+	counts, err := s.dao.GetOperationsDailyCounts(filters.Operations{
+		Token: filter.Identifier,
+		Type:  append([]string{dmodels.ESDTNFTCreateOperation}, nftTransferOperations...),
+		From:  filter.From,
+		To:    filter.To,
+	})
+	if err != nil {
+		return stats, fmt.Errorf("dao.GetOperationsDailyCounts: %s", err.Error())
+	}
+	days := make(map[int64]smodels.NFTCollectionDailyStat)
+	for _, c := range counts {
+		d := days[c.Day.Unix()]
+		if c.Operation == dmodels.ESDTNFTCreateOperation {
+			d.Mints += c.Count
+		} else {
+			d.Transfers += c.Count
+		}
+		days[c.Day.Unix()] = d
+	}
+	stats = toNFTCollectionStatsSModel(collection.NFTCollectionStats)
+	stats.Daily = make([]smodels.NFTCollectionDailyStat, 0)
+	for t := filter.From.Time; !t.After(filter.To.Time); t = nextTime(t, filters.DayInterval) {
+		d := days[t.Unix()]
+		d.Time = smodels.NewTime(t)
+		stats.Daily = append(stats.Daily, d)
+	}
+	return stats, nil
+}
+
+func toNFTCollectionStatsSModel(stats dmodels.NFTCollectionStats) smodels.NFTCollectionStats {
+	creators := make([]string, 0)
+	_ = json.Unmarshal(stats.Creators, &creators)
+	return smodels.NFTCollectionStats{
+		Items:           stats.Items,
+		Holders:         stats.Holders,
+		MintsPerDay:     stats.MintsPerDay,
+		TransfersPerDay: stats.TransfersPerDay,
+		Creators:        creators,
+		UpdatedAt:       smodels.NewTime(stats.StatsUpdatedAt),
+	}
+}
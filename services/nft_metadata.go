@@ -0,0 +1,187 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/derrors"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/dao/postgres"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/services/nftmeta"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"net/http"
+	"path/filepath"
+	"time"
+)
+
+const (
+	nftMetadataBatch       = 20
+	nftMetadataLatest      = 50
+	nftMetadataMaxAttempts = 5
+	nftMetadataRetryDelay  = time.Minute * 10
+	nftThumbnailPath       = "/nft/%s/thumbnail"
+)
+
+// UpdateNFTMetadata queues the latest minted NFTs and resolves metadata of the queued ones
+func (s *ServiceFacade) UpdateNFTMetadata() {
+	err := s.updateNFTMetadata()
+	if err != nil {
+		log.Error("UpdateNFTMetadata: %s", err.Error())
+	}
+}
+
+func (s *ServiceFacade) updateNFTMetadata() error {
+	// the latest NFTs are queued only if the backend lists them, the queue is processed anyway
+	tokens, err := s.dao.GetNFTTokens(filters.NFTTokens{Pagination: filters.Pagination{Limit: nftMetadataLatest, Page: 1}})
+	if err != nil && err != derrors.NotSupported {
+		return fmt.Errorf("dao.GetNFTTokens: %s", err.Error())
+	}
+	identifiers := make([]string, len(tokens))
+	for i, t := range tokens {
+		identifiers[i] = t.Identifier
+	}
+	err = s.queueNFTMetadata(identifiers...)
+	if err != nil {
+		return fmt.Errorf("queueNFTMetadata: %s", err.Error())
+	}
+	items, err := s.dao.GetNFTMetadataQueue(nftMetadataBatch)
+	if err != nil {
+		return fmt.Errorf("dao.GetNFTMetadataQueue: %s", err.Error())
+	}
+	for _, item := range items {
+		item = s.resolveNFTMetadata(item)
+		err = s.dao.UpdateNFTMetadata(item)
+		if err != nil {
+			return fmt.Errorf("dao.UpdateNFTMetadata: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+func (s *ServiceFacade) queueNFTMetadata(identifiers ...string) error {
+	now := time.Now()
+	items := make([]dmodels.NFTMetadata, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		if identifier == "" {
+			continue
+		}
+		items = append(items, dmodels.NFTMetadata{
+			Identifier:    identifier,
+			Status:        dmodels.NFTMetadataPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+	return s.dao.CreateNFTMetadata(items)
+}
+
+// resolveNFTMetadata decodes attributes, fetches metadata JSON and makes a thumbnail of the image,
+// failed items are retried with the growing delay
+func (s *ServiceFacade) resolveNFTMetadata(item dmodels.NFTMetadata) dmodels.NFTMetadata {
+	now := time.Now()
+	item.UpdatedAt = now
+	item.Attempts++
+	fail := func(err error) dmodels.NFTMetadata {
+		item.Error = err.Error()
+		item.Status = dmodels.NFTMetadataFailed
+		item.NextAttemptAt = now.Add(nftMetadataRetryDelay * time.Duration(item.Attempts))
+		if item.Attempts >= nftMetadataMaxAttempts {
+			item.Status = dmodels.NFTMetadataAbandoned
+		}
+		log.Debug("resolveNFTMetadata(%s): %s", item.Identifier, err.Error())
+		return item
+	}
+	nft, err := s.dao.GetTokenInfo(item.Identifier)
+	if err != nil {
+		return fail(fmt.Errorf("dao.GetTokenInfo: %s", err.Error()))
+	}
+	if nft.Data == nil {
+		return fail(fmt.Errorf("empty data"))
+	}
+	attributes := nftmeta.DecodeAttributes(nft.Data.Attributes)
+	item.Attributes, _ = json.Marshal(attributes)
+	item.MetadataURL = s.nftResolver.MetadataURL(attributes, nft.Data.URIs)
+	item.ImageURL = s.nftResolver.ImageURL(nft.Data.URIs)
+	item.Status = dmodels.NFTMetadataNoMetadata
+	if item.MetadataURL != "" {
+		metadata, err := s.nftResolver.FetchMetadata(item.MetadataURL)
+		if err != nil {
+			return fail(fmt.Errorf("nftResolver.FetchMetadata: %s", err.Error()))
+		}
+		if metadata.Image != "" {
+			item.ImageURL = metadata.Image
+		}
+		item.Metadata, _ = json.Marshal(metadata)
+		item.Status = dmodels.NFTMetadataResolved
+	}
+	if item.ImageURL != "" {
+		item.Thumbnail, err = s.nftResolver.MakeThumbnail(item.ImageURL, s.cfg.NFTMetadata.ThumbnailsDir, item.Identifier, s.cfg.NFTMetadata.ThumbnailSize)
+		if err != nil {
+			// the image may be a video or any other media, so the thumbnail is optional
+			log.Debug("resolveNFTMetadata(%s): nftResolver.MakeThumbnail: %s", item.Identifier, err.Error())
+			item.Thumbnail = ""
+		}
+	}
+	item.Error = ""
+	return item
+}
+
+// getNFTMetadata returns the resolved metadata, unknown NFTs are put into the queue
+func (s *ServiceFacade) getNFTMetadata(identifier string) (*smodels.NFTMetadata, error) {
+	item, err := s.dao.GetNFTMetadata(identifier)
+	if err != nil {
+		if err.Error() != postgres.NoRowsError {
+			return nil, fmt.Errorf("dao.GetNFTMetadata: %s", err.Error())
+		}
+		err = s.queueNFTMetadata(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("queueNFTMetadata: %s", err.Error())
+		}
+		return &smodels.NFTMetadata{Status: dmodels.NFTMetadataPending, UpdatedAt: smodels.NewTime(time.Now())}, nil
+	}
+	metadata := &smodels.NFTMetadata{
+		Status:    item.Status,
+		Image:     item.ImageURL,
+		Error:     item.Error,
+		UpdatedAt: smodels.NewTime(item.UpdatedAt),
+	}
+	if len(item.Attributes) != 0 {
+		_ = json.Unmarshal(item.Attributes, &metadata.Attributes)
+	}
+	if len(item.Metadata) != 0 {
+		var m nftmeta.Metadata
+		_ = json.Unmarshal(item.Metadata, &m)
+		metadata.Name = m.Name
+		metadata.Description = m.Description
+		metadata.Traits = make([]smodels.NFTTrait, len(m.Attributes))
+		for i, a := range m.Attributes {
+			metadata.Traits[i] = smodels.NFTTrait{TraitType: a.TraitType, Value: a.Value}
+		}
+	}
+	if item.Thumbnail != "" {
+		metadata.Thumbnail = fmt.Sprintf(nftThumbnailPath, identifier)
+	}
+	return metadata, nil
+}
+
+// GetNFTThumbnail returns the path of the thumbnail file
+func (s *ServiceFacade) GetNFTThumbnail(identifier string) (path string, err error) {
+	notFound := smodels.Error{
+		Err:      "not found",
+		Msg:      "thumbnail not found",
+		HttpCode: http.StatusNotFound,
+	}
+	item, err := s.dao.GetNFTMetadata(identifier)
+	if err != nil {
+		if err.Error() == postgres.NoRowsError {
+			return path, notFound
+		}
+		return path, fmt.Errorf("dao.GetNFTMetadata: %s", err.Error())
+	}
+	if item.Thumbnail == "" {
+		return path, notFound
+	}
+	return filepath.Join(s.cfg.NFTMetadata.ThumbnailsDir, item.Thumbnail), nil
+}
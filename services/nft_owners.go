@@ -0,0 +1,112 @@
+package services
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"math/big"
+)
+
+const (
+	nftOwnersLimit = 100
+	// maxAccountCollectionNFTs limits the NFTs shown within one collection of the account
+	maxAccountCollectionNFTs = 100
+)
+
+var nftHistoryOperations = []string{
+	dmodels.ESDTNFTCreateOperation,
+	dmodels.ESDTNFTTransferOperation,
+	dmodels.MultiESDTNFTTransferOperation,
+	dmodels.ESDTNFTAddQuantityOperation,
+	dmodels.ESDTNFTBurnOperation,
+}
+
+// getNFTOwners returns the largest holders of NFT, SFT may have many of them
+func (s *ServiceFacade) getNFTOwners(identifier string) (owners []smodels.NFTOwner, err error) {
+	collection, nonce := node.SplitTokenIdentifier(identifier)
+	if nonce == 0 {
+		return nil, nil
+	}
+	accounts, err := s.dao.GetESDTAccounts(filters.ESDT{
+		TokenIdentifier: collection,
+		TokenNonce:      nonce,
+		Pagination:      filters.Pagination{Limit: nftOwnersLimit},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetESDTAccounts: %s", err.Error())
+	}
+	owners = make([]smodels.NFTOwner, len(accounts))
+	for i, acc := range accounts {
+		owners[i] = smodels.NFTOwner{
+			Address:  acc.Address,
+			Quantity: acc.Balance,
+		}
+	}
+	return owners, nil
+}
+
+// GetNFTTransfers returns the operations with NFT: creation, transfers, quantity changes and burning
+func (s *ServiceFacade) GetNFTTransfers(filter filters.Operations) (items smodels.Pagination, err error) {
+	if len(filter.Type) == 0 {
+		filter.Type = nftHistoryOperations
+	}
+	items, err = s.GetOperations(filter)
+	if err != nil {
+		return items, fmt.Errorf("GetOperations: %s", err.Error())
+	}
+	return items, nil
+}
+
+// GetAccountNFTs returns the NFTs of the account grouped by the collections, the pagination is applied to the collections
+func (s *ServiceFacade) GetAccountNFTs(filter filters.ESDT) (items smodels.Pagination, err error) {
+	filter.NFT = true
+	identifiers, err := s.dao.GetESDTCollections(filter)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetESDTCollections: %s", err.Error())
+	}
+	total, err := s.dao.GetESDTCollectionsCount(filter)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetESDTCollectionsCount: %s", err.Error())
+	}
+	collections := make([]smodels.AccountNFTCollection, len(identifiers))
+	for i, identifier := range identifiers {
+		accounts, err := s.dao.GetESDTAccounts(filters.ESDT{
+			Address:         filter.Address,
+			TokenIdentifier: identifier,
+			NFT:             true,
+			Pagination:      filters.Pagination{Limit: maxAccountCollectionNFTs},
+		})
+		if err != nil {
+			return items, fmt.Errorf("dao.GetESDTAccounts: %s", err.Error())
+		}
+		name := identifier
+		collection, err := s.dao.GetNFTCollection(identifier)
+		if err == nil {
+			name = collection.Name
+		}
+		collections[i] = smodels.AccountNFTCollection{
+			Collection: identifier,
+			Name:       name,
+			NFTs:       make([]smodels.AccountNFT, len(accounts)),
+		}
+		for j, acc := range accounts {
+			collections[i].NFTs[j] = smodels.AccountNFT{
+				Identifier: fmt.Sprintf("%s-%s", acc.Token, nonceToHex(acc.TokenNonce)),
+				Nonce:      acc.TokenNonce,
+				Quantity:   acc.Balance,
+			}
+		}
+	}
+	return smodels.Pagination{
+		Items: collections,
+		Count: total,
+	}, nil
+}
+
+// nonceToHex encodes the nonce the same way as in NFT identifiers, e.g. 1 -> 01, 256 -> 0100
+func nonceToHex(nonce uint64) string {
+	return hex.EncodeToString((&big.Int{}).SetUint64(nonce).Bytes())
+}
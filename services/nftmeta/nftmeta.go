@@ -0,0 +1,332 @@
+package nftmeta
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	ipfsScheme      = "ipfs://"
+	ipfsPath        = "/ipfs/"
+	metadataKey     = "metadata"
+	maxMetadataSize = 1 << 20  // 1 MB
+	maxImageSize    = 20 << 20 // 20 MB
+	maxImagePixels  = 50e6     // the decoded image takes 4-8 bytes per pixel
+	thumbnailExt    = ".jpg"
+	maxRedirects    = 10
+)
+
+// reservedNetworks are private, loopback, link-local and other special-purpose networks,
+// URIs of NFTs are set by anyone, so they must not reach the internal services
+var reservedNetworks = parseCIDRs(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12",
+	"192.0.0.0/24", "192.0.2.0/24", "192.88.99.0/24", "192.168.0.0/16", "198.18.0.0/15", "198.51.100.0/24",
+	"203.0.113.0/24", "224.0.0.0/4", "240.0.0.0/4",
+	"::/128", "::1/128", "64:ff9b::/96", "100::/64", "2001:db8::/32", "fc00::/7", "fe80::/10", "ff00::/8",
+)
+
+type (
+	Resolver struct {
+		client  *http.Client
+		gateway string
+	}
+	// Metadata is a normalized metadata JSON of NFT
+	Metadata struct {
+		Name        string      `json:"name"`
+		Description string      `json:"description"`
+		Image       string      `json:"image"`
+		Attributes  []Attribute `json:"attributes"`
+	}
+	Attribute struct {
+		TraitType string `json:"trait_type"`
+		Value     string `json:"value"`
+	}
+)
+
+func NewResolver(gateway string) *Resolver {
+	if !strings.HasSuffix(gateway, "/") {
+		gateway += "/"
+	}
+	dialer := &net.Dialer{
+		Timeout: time.Second * 30,
+		Control: denyReserved,
+	}
+	return &Resolver{
+		client: &http.Client{
+			Timeout: time.Second * 30,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: time.Second * 10,
+			},
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxRedirects)
+				}
+				return checkScheme(req.URL)
+			},
+		},
+		gateway: gateway,
+	}
+}
+
+// DecodeAttributes decodes attributes in the common format, e.g. tags:art,music;metadata:<CID>/1.json
+func DecodeAttributes(raw []byte) map[string]string {
+	attributes := make(map[string]string)
+	for _, part := range strings.Split(string(raw), ";") {
+		kv := strings.SplitN(part, ":", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			continue
+		}
+		attributes[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	if len(attributes) == 0 && len(raw) != 0 {
+		attributes["raw"] = string(raw)
+	}
+	return attributes
+}
+
+// MetadataURL looks for the metadata link in the attributes and then among the URIs
+func (r *Resolver) MetadataURL(attributes map[string]string, uris [][]byte) string {
+	if cid, ok := attributes[metadataKey]; ok && cid != "" {
+		return r.URL(cid)
+	}
+	for _, uri := range uris {
+		u := string(uri)
+		if strings.HasSuffix(strings.ToLower(u), ".json") {
+			return r.URL(u)
+		}
+	}
+	return ""
+}
+
+// ImageURL returns the first URI which isn't a metadata link
+func (r *Resolver) ImageURL(uris [][]byte) string {
+	for _, uri := range uris {
+		u := string(uri)
+		if u != "" && !strings.HasSuffix(strings.ToLower(u), ".json") {
+			return r.URL(u)
+		}
+	}
+	return ""
+}
+
+// URL converts ipfs://<CID>, bare CIDs and links to other IPFS gateways to the link of the configured gateway
+func (r *Resolver) URL(uri string) string {
+	uri = strings.TrimSpace(uri)
+	switch {
+	case strings.HasPrefix(uri, ipfsScheme):
+		return r.gateway + strings.TrimPrefix(uri, ipfsScheme)
+	case strings.HasPrefix(uri, "http://"), strings.HasPrefix(uri, "https://"):
+		if i := strings.Index(uri, ipfsPath); i != -1 {
+			return r.gateway + uri[i+len(ipfsPath):]
+		}
+		return uri
+	default:
+		return r.gateway + strings.TrimPrefix(uri, "/")
+	}
+}
+
+func (r *Resolver) FetchMetadata(url string) (metadata Metadata, err error) {
+	body, err := r.get(url, maxMetadataSize)
+	if err != nil {
+		return metadata, err
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(body, &fields)
+	if err != nil {
+		return metadata, fmt.Errorf("json.Unmarshal: %s", err.Error())
+	}
+	metadata = Metadata{
+		Name:        rawToString(fields["name"]),
+		Description: rawToString(fields["description"]),
+		Image:       rawToString(fields["image"]),
+		Attributes:  make([]Attribute, 0),
+	}
+	if metadata.Image != "" {
+		metadata.Image = r.URL(metadata.Image)
+	}
+	var list []map[string]json.RawMessage
+	if json.Unmarshal(fields["attributes"], &list) == nil {
+		for _, item := range list {
+			metadata.Attributes = append(metadata.Attributes, Attribute{
+				TraitType: rawToString(item["trait_type"]),
+				Value:     rawToString(item["value"]),
+			})
+		}
+		return metadata, nil
+	}
+	var dict map[string]json.RawMessage
+	if json.Unmarshal(fields["attributes"], &dict) == nil {
+		for key, value := range dict {
+			metadata.Attributes = append(metadata.Attributes, Attribute{
+				TraitType: key,
+				Value:     rawToString(value),
+			})
+		}
+	}
+	return metadata, nil
+}
+
+// MakeThumbnail downloads the image and saves it scaled to fit into size x size, returns the file name
+func (r *Resolver) MakeThumbnail(url string, dir string, identifier string, size uint) (fileName string, err error) {
+	body, err := r.get(url, maxImageSize)
+	if err != nil {
+		return fileName, err
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		return fileName, fmt.Errorf("image.DecodeConfig: %s", err.Error())
+	}
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return fileName, fmt.Errorf("image is too large: %dx%d", cfg.Width, cfg.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(body))
+	if err != nil {
+		return fileName, fmt.Errorf("image.Decode: %s", err.Error())
+	}
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return fileName, fmt.Errorf("os.MkdirAll: %s", err.Error())
+	}
+	fileName = ThumbnailName(identifier)
+	tmpPath := filepath.Join(dir, fileName+".tmp")
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return fileName, fmt.Errorf("os.Create: %s", err.Error())
+	}
+	err = jpeg.Encode(file, scale(img, int(size)), &jpeg.Options{Quality: 85})
+	file.Close()
+	if err != nil {
+		os.Remove(tmpPath)
+		return fileName, fmt.Errorf("jpeg.Encode: %s", err.Error())
+	}
+	err = os.Rename(tmpPath, filepath.Join(dir, fileName))
+	if err != nil {
+		return fileName, fmt.Errorf("os.Rename: %s", err.Error())
+	}
+	return fileName, nil
+}
+
+// ThumbnailName returns the file name of the NFT thumbnail
+func ThumbnailName(identifier string) string {
+	return strings.NewReplacer("/", "_", "\\", "_", "..", "_").Replace(identifier) + thumbnailExt
+}
+
+func (r *Resolver) get(rawURL string, limit int64) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("url.Parse: %s", err.Error())
+	}
+	err = checkScheme(u)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Get(u.String())
+	if err != nil {
+		return nil, fmt.Errorf("client.Get: %s", err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad status: %d", resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("ioutil.ReadAll: %s", err.Error())
+	}
+	if int64(len(body)) > limit {
+		return nil, fmt.Errorf("response is larger than %d bytes", limit)
+	}
+	return body, nil
+}
+
+// scale resizes the image with the nearest neighbor interpolation keeping the aspect ratio
+func scale(src image.Image, size int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= size && h <= size {
+		return src
+	}
+	dw, dh := size, h*size/w
+	if h > w {
+		dw, dh = w*size/h, size
+	}
+	if dw == 0 {
+		dw = 1
+	}
+	if dh == 0 {
+		dh = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			dst.Set(x, y, src.At(b.Min.X+x*w/dw, b.Min.Y+y*h/dh))
+		}
+	}
+	return dst
+}
+
+func checkScheme(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme %s is not allowed", u.Scheme)
+	}
+	return nil
+}
+
+// denyReserved is called with the resolved address before the connection, so it covers DNS names and redirects
+func denyReserved(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("net.SplitHostPort: %s", err.Error())
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid ip %s", host)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	for _, n := range reservedNetworks {
+		if n.Contains(ip) {
+			return fmt.Errorf("address %s is not allowed", host)
+		}
+	}
+	return nil
+}
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = n
+	}
+	return networks
+}
+
+func rawToString(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	return string(raw)
+}
@@ -0,0 +1,48 @@
+package nftmeta
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeAttributes(t *testing.T) {
+	attrs := DecodeAttributes([]byte("tags:art,music;metadata:QmHash/1.json"))
+	expected := map[string]string{"tags": "art,music", "metadata": "QmHash/1.json"}
+	if !reflect.DeepEqual(attrs, expected) {
+		t.Error("not equal", attrs, expected)
+	}
+	attrs = DecodeAttributes([]byte("plain text"))
+	if attrs["raw"] != "plain text" {
+		t.Error("raw attributes are lost", attrs)
+	}
+}
+
+func TestURL(t *testing.T) {
+	r := NewResolver("https://gateway.test/ipfs")
+	cases := map[string]string{
+		"ipfs://QmHash/1.png":               "https://gateway.test/ipfs/QmHash/1.png",
+		"QmHash/1.json":                     "https://gateway.test/ipfs/QmHash/1.json",
+		"https://ipfs.io/ipfs/QmHash/1.png": "https://gateway.test/ipfs/QmHash/1.png",
+		"https://example.com/images/1.png":  "https://example.com/images/1.png",
+	}
+	for uri, expected := range cases {
+		if u := r.URL(uri); u != expected {
+			t.Error("wrong url", uri, u)
+		}
+	}
+}
+
+func TestGetReserved(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+	r := NewResolver("https://gateway.test/ipfs")
+	for _, u := range []string{server.URL, "http://169.254.169.254/latest/meta-data/", "http://[::1]/", "file:///etc/passwd"} {
+		if _, err := r.get(u, maxMetadataSize); err == nil {
+			t.Error("request is not denied", u)
+		}
+	}
+}
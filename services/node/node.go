@@ -39,10 +39,15 @@ const (
 	esdtsEndpoint                = "/network/esdts"
 	fungibleESDTEndpoint         = "/network/esdt/fungible-tokens"
 	esdtSupplyEndpoint           = "/network/esdt/supply/%s"
+	esdtBalanceEndpoint          = "/address/%s/esdt/%s"
+	nftBalanceEndpoint           = "/address/%s/nft/%s/nonce/%d"
 )
 
 var precisionDiv = decimal.New(1, Precision)
 
+// ErrNotFound is returned when the node responds with 404, e.g. the account doesn't hold the token
+var ErrNotFound = errors.New("not found")
+
 type (
 	API struct {
 		client    *http.Client
@@ -74,6 +79,7 @@ type (
 		GetProviderMeta(provider string) (config ProviderMeta, err error)
 		GetProviderNumUsers(provider string) (count uint64, err error)
 		GetCumulatedRewards(provider string) (amount decimal.Decimal, err error)
+		GetUserActiveStake(account, provider string) (amount decimal.Decimal, err error)
 		GetQueue() (items []QueueItem, err error)
 		GetOwner(bls string) (owner string, err error)
 		GetTotalStakedTopUpStakedBlsKeys(address string) (stake StakeTopup, err error)
@@ -82,6 +88,7 @@ type (
 		GetESDTs() (tokens []string, err error)
 		GetFungibleESDTs() (tokens []string, err error)
 		GetESDTSupply(ident string) (supply decimal.Decimal, err error)
+		GetESDTBalance(address string, token string, nonce uint64) (balance decimal.Decimal, err error)
 	}
 )
 
@@ -166,6 +173,19 @@ func (api *API) GetESDTSupply(ident string) (supply decimal.Decimal, err error)
 	return supply, err
 }
 
+// GetESDTBalance returns the balance of fungible token or of single NFT/SFT (nonce > 0)
+func (api *API) GetESDTBalance(address string, token string, nonce uint64) (balance decimal.Decimal, err error) {
+	endpoint := fmt.Sprintf(esdtBalanceEndpoint, address, token)
+	if nonce > 0 {
+		endpoint = fmt.Sprintf(nftBalanceEndpoint, address, token, nonce)
+	}
+	var tokenData struct {
+		Balance decimal.Decimal `json:"balance"`
+	}
+	err = api.get(endpoint, &tokenData, "tokenData")
+	return tokenData.Balance, err
+}
+
 func (api *API) GetUserStake(address string) (us UserStake, err error) {
 	hexAddress, err := addressToHex(address)
 	if err != nil {
@@ -553,6 +573,9 @@ func (api *API) get(endpoint string, result interface{}, field string) error {
 		return fmt.Errorf("client.Get: %s", err.Error())
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("bad status: %d", resp.StatusCode)
 	}
@@ -637,6 +660,32 @@ func base64ToAddress(b64 string) (address string, err error) {
 	if err != nil {
 		return address, fmt.Errorf("base64.DecodeString: %s", err.Error())
 	}
+	return bytesToAddress(data)
+}
+
+func HexToAddress(hexAddress string) (address string, err error) {
+	data, err := hex.DecodeString(hexAddress)
+	if err != nil {
+		return address, fmt.Errorf("hex.DecodeString: %s", err.Error())
+	}
+	return bytesToAddress(data)
+}
+
+// SplitTokenIdentifier splits NFT identifier (e.g. COL-a1b2c3-0a) into collection and nonce,
+// the identifier without the valid hex nonce is returned as is with the zero nonce
+func SplitTokenIdentifier(identifier string) (collection string, nonce uint64) {
+	parts := strings.Split(identifier, "-")
+	if len(parts) < 3 {
+		return identifier, 0
+	}
+	n, ok := (&big.Int{}).SetString(parts[2], 16)
+	if !ok {
+		return identifier, 0
+	}
+	return strings.Join(parts[:2], "-"), n.Uint64()
+}
+
+func bytesToAddress(data []byte) (address string, err error) {
 	conv, err := bech32.ConvertBits(data, 8, 5, true)
 	if err != nil {
 		return address, fmt.Errorf("bech32.ConvertBits: %s", err.Error())
@@ -76,6 +76,10 @@ func (s *ServiceFacade) updateNodes() error {
 	if err != nil {
 		return fmt.Errorf("node.GetQueue: %s", err.Error())
 	}
+	err = s.saveQueueSnapshot(queue)
+	if err != nil {
+		log.Error("updateNodes: saveQueueSnapshot: %s", err.Error())
+	}
 	for _, item := range queue {
 		n, ok := nodesMap[item.BLS]
 		if ok {
@@ -214,6 +218,16 @@ func (s *ServiceFacade) GetNode(key string) (node smodels.Node, err error) {
 	}
 	for _, n := range nodes {
 		if n.PublicKey == key {
+			n.Epochs, err = s.getValidatorEpochs([]string{key})
+			if err != nil {
+				return node, fmt.Errorf("getValidatorEpochs: %s", err.Error())
+			}
+			if n.Status == smodels.NodeStatusQueued {
+				n.Queue, err = s.getNodeQueue(n)
+				if err != nil {
+					return node, fmt.Errorf("getNodeQueue: %s", err.Error())
+				}
+			}
 			return n, nil
 		}
 	}
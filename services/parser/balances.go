@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/shopspring/decimal"
+	"strings"
+	"time"
+)
+
+const addressLength = 62
+
+// recordBalanceChanges approximates balance deltas of the tx participants, the sender pays
+// the whole gas limit and gets the unused gas back with the refund result
+func (d *data) recordBalanceChanges(tx node.Tx, txHash string, txData string, block uint64, t time.Time) error {
+	value, err := decimal.NewFromString(tx.Value)
+	if err != nil {
+		return fmt.Errorf("decimal.NewFromString: %s", err.Error())
+	}
+	add := func(address string, token string, amount decimal.Decimal) {
+		if len(address) != addressLength || amount.IsZero() {
+			return
+		}
+		d.balanceChanges = append(d.balanceChanges, dmodels.BalanceChange{
+			Address:   address,
+			Token:     token,
+			Block:     block,
+			Amount:    amount,
+			CreatedAt: t,
+		})
+	}
+	status := strings.ToLower(tx.Status)
+	add(tx.Sender, dmodels.EGLDToken, decimal.New(int64(tx.GasLimit), 0).Mul(decimal.New(int64(tx.GasPrice), 0)).Neg())
+	for _, r := range tx.SmartContractResults {
+		if isGasRefund(tx, r) {
+			add(r.Receiver, dmodels.EGLDToken, r.Value)
+		}
+	}
+	if status != dmodels.TxStatusSuccess {
+		return nil
+	}
+	add(tx.Sender, dmodels.EGLDToken, value.Neg())
+	add(tx.Receiver, dmodels.EGLDToken, value)
+
+	txOp, txOpOk := parseOperation(operationSource{sender: tx.Sender, receiver: tx.Receiver, data: txData}, t)
+	if txOpOk {
+		d.addESDTBalanceChanges(txOp, add)
+		d.addTokenTransfers(txOp, block, t)
+	}
+	for _, r := range tx.SmartContractResults {
+		if isGasRefund(tx, r) {
+			continue
+		}
+		add(r.Sender, dmodels.EGLDToken, r.Value.Neg())
+		add(r.Receiver, dmodels.EGLDToken, r.Value)
+		op, ok := parseOperation(operationSource{sender: r.Sender, receiver: r.Receiver, data: decodeSCRData(r.Data)}, t)
+		if !ok {
+			continue
+		}
+		// cross-shard transfer is executed on the destination shard with the same call
+		if txOpOk && op.Operation == txOp.Operation && op.Sender == txOp.Sender && equalTokens(op.Tokens, txOp.Tokens) {
+			continue
+		}
+		d.addESDTBalanceChanges(op, add)
+		d.addTokenTransfers(op, block, t)
+	}
+	return nil
+}
+
+func (d *data) addESDTBalanceChanges(op dmodels.Operation, add func(address string, token string, amount decimal.Decimal)) {
+	for i, token := range op.Tokens {
+		amount, _ := decimal.NewFromString(op.ESDTValues[i])
+		switch op.Operation {
+		case dmodels.ESDTTransferOperation, dmodels.ESDTNFTTransferOperation, dmodels.MultiESDTNFTTransferOperation:
+			add(op.Sender, token, amount.Neg())
+			add(op.Receiver, token, amount)
+		case dmodels.ESDTLocalMintOperation, dmodels.ESDTNFTAddQuantityOperation:
+			add(op.Sender, token, amount)
+		case dmodels.ESDTLocalBurnOperation, dmodels.ESDTNFTBurnOperation:
+			add(op.Sender, token, amount.Neg())
+		}
+	}
+}
+
+// addTokenTransfers counts transfers per token, NFTs are counted by their collection
+func (d *data) addTokenTransfers(op dmodels.Operation, block uint64, t time.Time) {
+	switch op.Operation {
+	case dmodels.ESDTTransferOperation, dmodels.ESDTNFTTransferOperation, dmodels.MultiESDTNFTTransferOperation:
+	default:
+		return
+	}
+	for i, identifier := range op.Tokens {
+		amount, _ := decimal.NewFromString(op.ESDTValues[i])
+		token, _ := node.SplitTokenIdentifier(identifier)
+		d.tokenTransfers = append(d.tokenTransfers, dmodels.TokenTransfer{
+			Token:     token,
+			Block:     block,
+			Count:     1,
+			Volume:    amount,
+			CreatedAt: t,
+		})
+	}
+}
+
+// mergeTokenTransfers sums the transfers of the same token within a block
+func mergeTokenTransfers(transfers []dmodels.TokenTransfer) (result []dmodels.TokenTransfer) {
+	indexes := make(map[string]int)
+	for _, tr := range transfers {
+		key := fmt.Sprintf("%s_%d", tr.Token, tr.Block)
+		i, ok := indexes[key]
+		if !ok {
+			indexes[key] = len(result)
+			result = append(result, tr)
+			continue
+		}
+		result[i].Count += tr.Count
+		result[i].Volume = result[i].Volume.Add(tr.Volume)
+	}
+	return result
+}
+
+// mergeBalanceChanges sums the changes of the same address and token within a block
+func mergeBalanceChanges(changes []dmodels.BalanceChange) (result []dmodels.BalanceChange) {
+	indexes := make(map[string]int)
+	for _, c := range changes {
+		key := fmt.Sprintf("%s_%s_%d", c.Address, c.Token, c.Block)
+		i, ok := indexes[key]
+		if !ok {
+			indexes[key] = len(result)
+			result = append(result, c)
+			continue
+		}
+		result[i].Amount = result[i].Amount.Add(c.Amount)
+	}
+	return result
+}
+
+func equalTokens(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,201 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	deployAddress          = "erd1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq6gq4hu"
+	upgradeContractFunc    = "upgradeContract"
+	changeOwnerAddressFunc = "ChangeOwnerAddress"
+	// the address of the smart contract starts with 8 zero bytes and 2 bytes of VM type
+	scAddressZeroBytes = 8
+	vmTypeLen          = 2
+	addressLen         = 32
+	maxFunctionLen     = 255
+)
+
+// wasmVMType is the VM type of the user smart contracts, the system ones have another type
+var wasmVMType = []byte{5, 0}
+
+// recordContracts collects deployments, upgrades, owner changes and function calls of the smart contracts
+func (d *data) recordContracts(tx node.Tx, txHash string, txData string, block uint64, t time.Time) error {
+	success := strings.ToLower(tx.Status) == dmodels.TxStatusSuccess
+	parts := strings.Split(txData, "@")
+	if tx.Receiver == deployAddress {
+		if !success || len(parts) < 2 {
+			return nil
+		}
+		address, err := newContractAddress(tx.Sender, tx.Nonce, parts[1])
+		if err != nil {
+			return fmt.Errorf("newContractAddress: %s", err.Error())
+		}
+		d.contracts = append(d.contracts, dmodels.Contract{
+			Address:      address,
+			Deployer:     tx.Sender,
+			Owner:        tx.Sender,
+			CodeHash:     codeHash(parts[0]),
+			DeployTxHash: txHash,
+			DeployedAt:   t,
+			UpdatedAt:    t,
+		})
+		return nil
+	}
+	contract, function := contractCall(tx.Sender, tx.Receiver, parts)
+	if contract == "" {
+		return nil
+	}
+	if success && contract == tx.Receiver {
+		switch function {
+		case upgradeContractFunc:
+			if len(parts) > 1 {
+				d.contractChanges = append(d.contractChanges, dmodels.Contract{
+					Address:       contract,
+					Owner:         tx.Sender,
+					CodeHash:      codeHash(parts[1]),
+					UpgradeTxHash: txHash,
+					DeployedAt:    time.Unix(0, 0),
+					UpdatedAt:     t,
+				})
+			}
+		case changeOwnerAddressFunc:
+			if len(parts) > 1 {
+				owner, err := node.HexToAddress(parts[1])
+				if err != nil {
+					return fmt.Errorf("node.HexToAddress: %s", err.Error())
+				}
+				d.contractChanges = append(d.contractChanges, dmodels.Contract{
+					Address:    contract,
+					Owner:      owner,
+					DeployedAt: time.Unix(0, 0),
+					UpdatedAt:  t,
+				})
+			}
+		}
+	}
+	d.contractCalls = append(d.contractCalls, dmodels.ContractCall{
+		Contract:   contract,
+		Function:   function,
+		Calls:      1,
+		Block:      block,
+		LastCallAt: t,
+	})
+	return nil
+}
+
+// contractCall returns the called contract and function, the calls with ESDT transfers are unwrapped,
+// the function of the unwrapped call is hex encoded
+func contractCall(sender string, receiver string, parts []string) (contract string, function string) {
+	contract, function = receiver, parts[0]
+	switch parts[0] {
+	case dmodels.ESDTTransferOperation:
+		// ESDTTransfer@token@value@function
+		function = hexToString(argAt(parts, 3))
+	case dmodels.ESDTNFTTransferOperation:
+		// ESDTNFTTransfer@token@nonce@value@receiver@function, the tx is sent to itself
+		if sender != receiver || len(parts) < 5 {
+			return "", ""
+		}
+		contract, _ = node.HexToAddress(parts[4])
+		function = hexToString(argAt(parts, 5))
+	case dmodels.MultiESDTNFTTransferOperation:
+		// MultiESDTNFTTransfer@receiver@count(@token@nonce@value)...@function
+		if sender != receiver || len(parts) < 3 {
+			return "", ""
+		}
+		count := new(big.Int).SetBytes(hexToBytes(parts[2]))
+		if !count.IsUint64() || count.Uint64() > uint64(len(parts)-3)/3 {
+			return "", ""
+		}
+		contract, _ = node.HexToAddress(parts[1])
+		function = hexToString(argAt(parts, 3+int(count.Uint64())*3))
+	}
+	if function == "" || len(function) > maxFunctionLen || !isContractAddress(contract) {
+		return "", ""
+	}
+	return contract, function
+}
+
+func argAt(parts []string, i int) string {
+	if i >= 0 && i < len(parts) {
+		return parts[i]
+	}
+	return ""
+}
+
+// isContractAddress checks that the address belongs to the user smart contract
+func isContractAddress(address string) bool {
+	b, err := addressToBytes(address)
+	if err != nil || len(b) != addressLen {
+		return false
+	}
+	for _, v := range b[:scAddressZeroBytes] {
+		if v != 0 {
+			return false
+		}
+	}
+	return bytes.Equal(b[scAddressZeroBytes:scAddressZeroBytes+vmTypeLen], wasmVMType)
+}
+
+// newContractAddress computes the address of the deployed contract the same way as the node does:
+// 8 zero bytes, VM type, keccak(creator + nonce)[10:30] and the last 2 bytes of the creator
+func newContractAddress(creator string, nonce uint64, vmTypeHex string) (address string, err error) {
+	creatorBytes, err := addressToBytes(creator)
+	if err != nil {
+		return address, fmt.Errorf("addressToBytes: %s", err.Error())
+	}
+	vmType, err := hex.DecodeString(vmTypeHex)
+	if err != nil || len(vmType) != vmTypeLen {
+		return address, fmt.Errorf("invalid vm type: %s", vmTypeHex)
+	}
+	nonceBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nonceBytes, nonce)
+	base := keccak.NewKeccak().Compute(string(append(append([]byte{}, creatorBytes...), nonceBytes...)))
+	copy(base[:scAddressZeroBytes], make([]byte, scAddressZeroBytes))
+	copy(base[scAddressZeroBytes:scAddressZeroBytes+vmTypeLen], vmType)
+	copy(base[len(base)-2:], creatorBytes[len(creatorBytes)-2:])
+	return node.HexToAddress(hex.EncodeToString(base))
+}
+
+func codeHash(codeHex string) string {
+	code, _ := hex.DecodeString(codeHex)
+	return hex.EncodeToString(blake2b.NewBlake2b().Compute(string(code)))
+}
+
+func addressToBytes(address string) ([]byte, error) {
+	_, buff, err := bech32.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("bech32.Decode: %s", err.Error())
+	}
+	return bech32.ConvertBits(buff, 5, 8, false)
+}
+
+// mergeContractCalls sums the calls of the same function within every block of the batch
+func mergeContractCalls(calls []dmodels.ContractCall) (result []dmodels.ContractCall) {
+	indexes := make(map[string]int)
+	for _, c := range calls {
+		key := fmt.Sprintf("%s_%s_%d", c.Contract, c.Function, c.Block)
+		i, ok := indexes[key]
+		if !ok {
+			indexes[key] = len(result)
+			result = append(result, c)
+			continue
+		}
+		result[i].Calls += c.Calls
+		if c.LastCallAt.After(result[i].LastCallAt) {
+			result[i].LastCallAt = c.LastCallAt
+		}
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"strings"
+	"testing"
+)
+
+func TestNewContractAddress(t *testing.T) {
+	owner, err := node.HexToAddress("93ee6143cdc10ce79f15b2a6c2ad38e9b6021c72a1779051f47154fd54cfbd5e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[uint64]string{
+		0: "erd1qqqqqqqqqqqqqpgqhdjjyq8dr7v5yq9tv6v5vt9tfvd00vg7h40q6779zn",
+		1: "erd1qqqqqqqqqqqqqpgqde8eqjywyu6zlxjxuxqfg5kgtmn3setxh40qen8egy",
+	}
+	for nonce, address := range expected {
+		a, err := newContractAddress(owner, nonce, "0500")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a != address {
+			t.Error("wrong address", nonce, a)
+		}
+	}
+}
+
+func TestContractCall(t *testing.T) {
+	contract := "erd1qqqqqqqqqqqqqpgqhdjjyq8dr7v5yq9tv6v5vt9tfvd00vg7h40q6779zn"
+	contractHex := "00000000000000000500bb652200ed1f994200ab6699462cab4b1af7b11ebd5e"
+	sender, err := node.HexToAddress("93ee6143cdc10ce79f15b2a6c2ad38e9b6021c72a1779051f47154fd54cfbd5e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		sender   string
+		data     string
+		function string
+	}{
+		{sender: sender, data: "swap@01", function: "swap"},
+		{sender: sender, data: "ESDTTransfer@544f4b454e2d616263646566@0a@73776170", function: "swap"},
+		{sender: sender, data: "MultiESDTNFTTransfer@" + contractHex + "@01@544f4b454e2d616263646566@00@0a@73776170", function: "swap"},
+		// the count of the transfers doesn't fit in the arguments
+		{sender: sender, data: "MultiESDTNFTTransfer@" + contractHex + "@8000000000000000@73776170", function: ""},
+		{sender: sender, data: "MultiESDTNFTTransfer@" + contractHex + "@ffffffffffffffffff@73776170", function: ""},
+	}
+	for _, c := range cases {
+		receiver := contract
+		if strings.HasPrefix(c.data, "Multi") {
+			receiver = c.sender
+		}
+		_, function := contractCall(c.sender, receiver, strings.Split(c.data, "@"))
+		if function != c.function {
+			t.Error("wrong function", c.data, function)
+		}
+	}
+	if argAt([]string{"a"}, -1) != "" {
+		t.Error("negative index is not ignored")
+	}
+}
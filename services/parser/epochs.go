@@ -0,0 +1,29 @@
+package parser
+
+import (
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// recordEpoch adds the transactions, fees and rewards of the hyperblock to the epoch of its metachain block
+func (d *data) recordEpoch(metaBlock node.Block, rewards decimal.Decimal) {
+	t := time.Unix(metaBlock.Timestamp, 0)
+	epoch := dmodels.Epoch{
+		Epoch:      metaBlock.Epoch,
+		StartNonce: d.height,
+		EndNonce:   d.height,
+		StartTime:  t,
+		EndTime:    t,
+		Fees:       decimal.Zero,
+		Rewards:    rewards,
+	}
+	for _, item := range d.throughput {
+		epoch.Txs += item.Txs
+	}
+	for _, fee := range d.fees {
+		epoch.Fees = epoch.Fees.Add(fee.Fee)
+	}
+	d.epochs = append(d.epochs, epoch)
+}
@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/shopspring/decimal"
+	"strings"
+	"time"
+)
+
+// recordFee keeps the fee paid by the sender, it's used for the daily fee analytics
+func (d *data) recordFee(tx node.Tx, txHash string, txData string, t time.Time) {
+	fee, gasUsed := txFee(tx)
+	contract, _ := contractCall(tx.Sender, tx.Receiver, strings.Split(txData, "@"))
+	d.fees = append(d.fees, dmodels.TxFee{
+		Hash:      txHash,
+		Shard:     tx.SourceShard,
+		Contract:  contract,
+		Fee:       fee,
+		GasUsed:   gasUsed,
+		GasLimit:  tx.GasLimit,
+		CreatedAt: t,
+	})
+}
+
+// txFee calculates the fee of the transaction excluding the gas refunded by the smart contract results
+func txFee(tx node.Tx) (fee decimal.Decimal, gasUsed uint64) {
+	gasPrice := decimal.New(int64(tx.GasPrice), 0)
+	fee = decimal.New(int64(tx.GasLimit), 0).Mul(gasPrice)
+	for _, r := range tx.SmartContractResults {
+		if isGasRefund(tx, r) {
+			fee = fee.Sub(r.Value)
+		}
+	}
+	if fee.IsNegative() {
+		fee = decimal.Zero
+	}
+	if tx.GasPrice != 0 {
+		gasUsed = uint64(fee.Div(gasPrice).IntPart())
+	}
+	return fee, gasUsed
+}
@@ -0,0 +1,355 @@
+package parser
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/config"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/shopspring/decimal"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	txMiniblockType        = "TxBlock"
+	rewardsMiniblockType   = "RewardsBlock"
+	scResultsMiniblockType = "SmartContractResultBlock"
+)
+
+// indexing is enabled when the chain data is served from postgres instead of elasticsearch
+func (p *Parser) indexing() bool {
+	return p.cfg.Backend == config.PostgresBackend
+}
+
+func (d *data) indexBlock(block node.Block, t time.Time) {
+	mbHashes := make([]string, len(block.Miniblocks))
+	for i, mb := range block.Miniblocks {
+		mbHashes[i] = mb.Hash
+		miniBlock := dmodels.MiniBlock{
+			Hash:          mb.Hash,
+			ReceiverShard: mb.DestinationShard,
+			SenderShard:   mb.SourceShard,
+			Type:          mb.Type,
+			CreatedAt:     t,
+		}
+		if mb.SourceShard == block.Shard {
+			miniBlock.SenderBlockHash = block.Hash
+		}
+		if mb.DestinationShard == block.Shard {
+			miniBlock.ReceiverBlockHash = block.Hash
+		}
+		d.miniblocks = append(d.miniblocks, miniBlock)
+	}
+	d.blocks = append(d.blocks, dmodels.Block{
+		Hash:            block.Hash,
+		Nonce:           block.Nonce,
+		Round:           block.Round,
+		Shard:           block.Shard,
+		NumTxs:          block.NumTxs,
+		Epoch:           block.Epoch,
+		Status:          block.Status,
+		PrevBlockHash:   block.PrevBlockHash,
+		MiniBlocks:      mbHashes,
+		AccumulatedFees: block.AccumulatedFees,
+		DeveloperFees:   block.DeveloperFees,
+		CreatedAt:       t,
+	})
+}
+
+func (d *data) indexTransaction(tx node.Tx, txHash string, txData string, t time.Time) error {
+	value, err := decimal.NewFromString(tx.Value)
+	if err != nil {
+		return fmt.Errorf("decimal.NewFromString: %s", err.Error())
+	}
+	fee, gasUsed := txFee(tx)
+	d.transactions = append(d.transactions, dmodels.Transaction{
+		Hash:          txHash,
+		Status:        strings.ToLower(tx.Status),
+		MiniBlockHash: tx.MiniblockHash,
+		Value:         value,
+		Sender:        tx.Sender,
+		SenderShard:   tx.SourceShard,
+		Receiver:      tx.Receiver,
+		ReceiverShard: tx.DestinationShard,
+		GasPrice:      tx.GasPrice,
+		GasLimit:      tx.GasLimit,
+		GasUsed:       gasUsed,
+		Fee:           fee,
+		Nonce:         tx.Nonce,
+		Round:         tx.Round,
+		Signature:     tx.Signature,
+		Data:          txData,
+		CreatedAt:     t,
+	})
+	d.accounts = append(d.accounts, tx.Sender, tx.Receiver)
+	d.indexOperation(operationSource{
+		hash:           txHash,
+		originalTxHash: txHash,
+		nonce:          tx.Nonce,
+		sender:         tx.Sender,
+		receiver:       tx.Receiver,
+		senderShard:    tx.SourceShard,
+		receiverShard:  tx.DestinationShard,
+		status:         strings.ToLower(tx.Status),
+		data:           txData,
+	}, t)
+	for _, r := range tx.SmartContractResults {
+		scrData := decodeSCRData(r.Data)
+		d.scResults = append(d.scResults, dmodels.SCResult{
+			Hash:      r.Hash,
+			TxHash:    txHash,
+			From:      r.Sender,
+			To:        r.Receiver,
+			Value:     r.Value,
+			Data:      scrData,
+			Message:   r.ReturnMessage,
+			CreatedAt: t,
+		})
+		d.accounts = append(d.accounts, r.Receiver)
+		d.indexOperation(operationSource{
+			hash:           r.Hash,
+			originalTxHash: txHash,
+			nonce:          r.Nonce,
+			sender:         r.Sender,
+			receiver:       r.Receiver,
+			senderShard:    tx.SourceShard,
+			receiverShard:  tx.DestinationShard,
+			status:         strings.ToLower(tx.Status),
+			data:           scrData,
+		}, t)
+	}
+	return nil
+}
+
+type operationSource struct {
+	hash           string
+	originalTxHash string
+	nonce          uint64
+	sender         string
+	receiver       string
+	senderShard    uint64
+	receiverShard  uint64
+	status         string
+	data           string
+}
+
+func (d *data) indexOperation(src operationSource, t time.Time) {
+	op, ok := parseOperation(src, t)
+	if !ok {
+		return
+	}
+	d.operations = append(d.operations, op)
+	if op.Status != dmodels.TxStatusSuccess || op.Operation == dmodels.ESDTNFTCreateOperation {
+		// the nonce of created token is known only from the results, so balance is updated on the first transfer
+		return
+	}
+	for _, identifier := range op.Tokens {
+		token, nonce := node.SplitTokenIdentifier(identifier)
+		d.esdtAccounts = append(d.esdtAccounts,
+			dmodels.ESDTAccount{Address: op.Sender, Token: token, TokenNonce: nonce, UpdatedAt: t},
+			dmodels.ESDTAccount{Address: op.Receiver, Token: token, TokenNonce: nonce, UpdatedAt: t},
+		)
+	}
+}
+
+// parseOperation parses ESDT built-in function calls, e.g. ESDTTransfer@<token>@<value>
+func parseOperation(src operationSource, t time.Time) (op dmodels.Operation, ok bool) {
+	parts := strings.Split(src.data, "@")
+	if len(parts) < 2 {
+		return op, false
+	}
+	args := parts[1:]
+	op = dmodels.Operation{
+		Hash:           src.hash,
+		OriginalTxHash: src.originalTxHash,
+		Nonce:          src.nonce,
+		Sender:         src.sender,
+		Receiver:       src.receiver,
+		SenderShard:    src.senderShard,
+		ReceiverShard:  src.receiverShard,
+		Operation:      parts[0],
+		Status:         src.status,
+		Data:           src.data,
+		CreatedAt:      t,
+	}
+	addToken := func(token string, nonceHex string, value string) {
+		identifier := token
+		if hexToUint64(nonceHex) > 0 {
+			identifier = fmt.Sprintf("%s-%s", token, nonceHex)
+		}
+		op.Tokens = append(op.Tokens, identifier)
+		op.ESDTValues = append(op.ESDTValues, hexToDecimal(value).String())
+	}
+	switch op.Operation {
+	case dmodels.ESDTTransferOperation:
+		if len(args) < 2 {
+			return op, false
+		}
+		addToken(hexToString(args[0]), "", args[1])
+	case dmodels.ESDTNFTTransferOperation:
+		if len(args) < 4 {
+			return op, false
+		}
+		receiver, err := node.HexToAddress(args[3])
+		if err != nil {
+			log.Warn("Parser [tx_hash: %s]: parseOperation: node.HexToAddress: %s", src.originalTxHash, err.Error())
+			return op, false
+		}
+		op.Receiver = receiver
+		addToken(hexToString(args[0]), args[1], args[2])
+	case dmodels.MultiESDTNFTTransferOperation:
+		if len(args) < 2 {
+			return op, false
+		}
+		receiver, err := node.HexToAddress(args[0])
+		if err != nil {
+			log.Warn("Parser [tx_hash: %s]: parseOperation: node.HexToAddress: %s", src.originalTxHash, err.Error())
+			return op, false
+		}
+		op.Receiver = receiver
+		count := int(hexToUint64(args[1]))
+		for i := 0; i < count && len(args) >= 2+(i+1)*3; i++ {
+			item := args[2+i*3:]
+			addToken(hexToString(item[0]), item[1], item[2])
+		}
+	case dmodels.ESDTLocalMintOperation, dmodels.ESDTLocalBurnOperation:
+		if len(args) < 2 {
+			return op, false
+		}
+		op.Receiver = op.Sender
+		addToken(hexToString(args[0]), "", args[1])
+	case dmodels.ESDTNFTBurnOperation, dmodels.ESDTNFTAddQuantityOperation:
+		if len(args) < 3 {
+			return op, false
+		}
+		op.Receiver = op.Sender
+		addToken(hexToString(args[0]), args[1], args[2])
+	case dmodels.ESDTNFTCreateOperation:
+		if len(args) < 2 {
+			return op, false
+		}
+		op.Receiver = op.Sender
+		addToken(hexToString(args[0]), "", args[1])
+	default:
+		return op, false
+	}
+	return op, true
+}
+
+// updateIndexedAccounts refreshes balances of the accounts touched in the saved blocks
+func (p *Parser) updateIndexedAccounts(addresses []string, esdtAccounts []dmodels.ESDTAccount) {
+	now := time.Now()
+	seen := make(map[string]struct{})
+	var accounts []dmodels.Account
+	for _, address := range addresses {
+		if _, ok := seen[address]; ok || address == "" {
+			continue
+		}
+		seen[address] = struct{}{}
+		for attempt := 1; ; attempt++ {
+			acc, err := p.node.GetAddress(address)
+			if err == nil {
+				accounts = append(accounts, dmodels.Account{
+					Address:   address,
+					Balance:   acc.Balance,
+					Nonce:     acc.Nonce,
+					CreatedAt: now,
+					UpdatedAt: now,
+				})
+				break
+			}
+			log.Error("Parser: node.GetAddress(%s): %s", address, err.Error())
+			if attempt == nodeAttempts {
+				log.Warn("Parser: account %s is not refreshed", address)
+				break
+			}
+			<-time.After(repeatDelay)
+		}
+	}
+	for {
+		err := p.dao.UpdateAccounts(accounts)
+		if err == nil {
+			break
+		}
+		log.Error("Parser: dao.UpdateAccounts: %s", err.Error())
+		<-time.After(repeatDelay)
+	}
+
+	seen = make(map[string]struct{})
+	var balances []dmodels.ESDTAccount
+	for _, acc := range esdtAccounts {
+		key := fmt.Sprintf("%s_%s_%d", acc.Address, acc.Token, acc.TokenNonce)
+		if _, ok := seen[key]; ok || acc.Address == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+		refreshed := false
+		for attempt := 1; ; attempt++ {
+			balance, err := p.node.GetESDTBalance(acc.Address, acc.Token, acc.TokenNonce)
+			if err == nil || err == node.ErrNotFound {
+				// the node responds with 404 when the account doesn't hold the token anymore
+				acc.Balance = balance
+				refreshed = true
+				break
+			}
+			log.Error("Parser: node.GetESDTBalance(%s, %s): %s", acc.Address, acc.Token, err.Error())
+			if attempt == nodeAttempts {
+				log.Warn("Parser: balance of %s in %s is not refreshed", acc.Address, acc.Token)
+				break
+			}
+			<-time.After(repeatDelay)
+		}
+		if !refreshed {
+			continue
+		}
+		acc.UpdatedAt = now
+		balances = append(balances, acc)
+	}
+	for {
+		err := p.dao.UpdateESDTAccounts(balances)
+		if err == nil {
+			break
+		}
+		log.Error("Parser: dao.UpdateESDTAccounts: %s", err.Error())
+		<-time.After(repeatDelay)
+	}
+}
+
+// isGasRefund checks if the result returns unused gas to the sender with the @ok message
+func isGasRefund(tx node.Tx, r node.SmartContractResult) bool {
+	return r.Receiver == tx.Sender && (r.Data == msgOKBase64 || r.Data == msgOKHex) && r.Value.IsPositive()
+}
+
+func decodeSCRData(d string) string {
+	if strings.Contains(d, "@") {
+		return d
+	}
+	decoded, err := base64.StdEncoding.DecodeString(d)
+	if err != nil {
+		return d
+	}
+	return string(decoded)
+}
+
+func hexToString(h string) string {
+	return string(hexToBytes(h))
+}
+
+func hexToBytes(h string) []byte {
+	b, _ := hex.DecodeString(h)
+	return b
+}
+
+func hexToUint64(h string) uint64 {
+	b, _ := hex.DecodeString(h)
+	return (&big.Int{}).SetBytes(b).Uint64()
+}
+
+func hexToDecimal(h string) decimal.Decimal {
+	b, _ := hex.DecodeString(h)
+	return decimal.NewFromBigInt((&big.Int{}).SetBytes(b), 0)
+}
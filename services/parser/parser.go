@@ -25,6 +25,9 @@ const (
 	saverChBuffer   = 5000
 	msgOKBase64     = "QDZmNmI=" // @ok
 	msgOKHex        = "@6f6b"    // @ok
+
+	// nodeAttempts bounds the requests of the account refresh, the account is skipped until its next change
+	nodeAttempts = 3
 )
 
 type (
@@ -47,6 +50,29 @@ type (
 		delegations []dmodels.Delegation
 		rewards     []dmodels.Reward
 		stakeEvents []dmodels.StakeEvent
+
+		balanceChanges []dmodels.BalanceChange
+		tokenTransfers []dmodels.TokenTransfer
+		changedTokens  []string
+		changedAt      time.Time
+		issuedTokens   []dmodels.TokenSync
+
+		contracts       []dmodels.Contract
+		contractChanges []dmodels.Contract
+		contractCalls   []dmodels.ContractCall
+
+		fees       []dmodels.TxFee
+		throughput []dmodels.ShardThroughput
+		epochs     []dmodels.Epoch
+
+		// postgres backend
+		blocks       []dmodels.Block
+		miniblocks   []dmodels.MiniBlock
+		transactions []dmodels.Transaction
+		scResults    []dmodels.SCResult
+		operations   []dmodels.Operation
+		accounts     []string
+		esdtAccounts []dmodels.ESDTAccount
 	}
 	ShardIndex uint64
 )
@@ -159,9 +185,16 @@ func (p *Parser) parseHyperBlock(nonce uint64) (d data, err error) {
 		hyperBlocks = append(hyperBlocks, block)
 	}
 
+	rewards := decimal.Zero
 	for _, block := range hyperBlocks {
 		t := time.Unix(block.Timestamp, 0)
 
+		d.recordThroughput(block, t)
+
+		if p.indexing() {
+			d.indexBlock(block, t)
+		}
+
 		for _, miniBlock := range block.Miniblocks {
 			for _, mbTx := range miniBlock.Transactions {
 				tx, err := p.node.GetTransaction(mbTx.Hash)
@@ -186,6 +219,35 @@ func (p *Parser) parseHyperBlock(nonce uint64) (d data, err error) {
 					return d, fmt.Errorf("base64.DecodeString: %s", err.Error())
 				}
 
+				if p.indexing() && (miniBlock.Type == txMiniblockType || miniBlock.Type == rewardsMiniblockType) {
+					err = d.indexTransaction(tx, mbTx.Hash, string(decodedBytes), t)
+					if err != nil {
+						return d, fmt.Errorf("[tx_hash: %s] indexTransaction: %s", mbTx.Hash, err.Error())
+					}
+				}
+
+				if block.Shard == miniBlock.SourceShard && miniBlock.Type != scResultsMiniblockType {
+					err = d.recordBalanceChanges(tx, mbTx.Hash, string(decodedBytes), nonce, t)
+					if err != nil {
+						return d, fmt.Errorf("[tx_hash: %s] recordBalanceChanges: %s", mbTx.Hash, err.Error())
+					}
+					d.recordTokenChanges(tx, string(decodedBytes), p.cfg.Contracts.ESDTContract, t)
+					err = d.recordContracts(tx, mbTx.Hash, string(decodedBytes), nonce, t)
+					if err != nil {
+						return d, fmt.Errorf("[tx_hash: %s] recordContracts: %s", mbTx.Hash, err.Error())
+					}
+					switch miniBlock.Type {
+					case txMiniblockType:
+						d.recordFee(tx, mbTx.Hash, string(decodedBytes), t)
+					case rewardsMiniblockType:
+						value, err := decimal.NewFromString(tx.Value)
+						if err != nil {
+							return d, fmt.Errorf("[tx_hash: %s] decimal.NewFromString: %s", mbTx.Hash, err.Error())
+						}
+						rewards = rewards.Add(value)
+					}
+				}
+
 				if tx.Status != dmodels.TxStatusSuccess {
 					continue
 				}
@@ -241,6 +303,7 @@ func (p *Parser) parseHyperBlock(nonce uint64) (d data, err error) {
 		}
 
 	}
+	d.recordEpoch(metaChainBlock, rewards)
 
 	return d, nil
 }
@@ -552,6 +615,26 @@ func (p *Parser) saving() {
 			singleData.delegations = append(singleData.delegations, item.delegations...)
 			singleData.rewards = append(singleData.rewards, item.rewards...)
 			singleData.stakeEvents = append(singleData.stakeEvents, item.stakeEvents...)
+			singleData.balanceChanges = append(singleData.balanceChanges, item.balanceChanges...)
+			singleData.tokenTransfers = append(singleData.tokenTransfers, item.tokenTransfers...)
+			singleData.changedTokens = append(singleData.changedTokens, item.changedTokens...)
+			singleData.issuedTokens = append(singleData.issuedTokens, item.issuedTokens...)
+			if item.changedAt.After(singleData.changedAt) {
+				singleData.changedAt = item.changedAt
+			}
+			singleData.contracts = append(singleData.contracts, item.contracts...)
+			singleData.contractChanges = append(singleData.contractChanges, item.contractChanges...)
+			singleData.contractCalls = append(singleData.contractCalls, item.contractCalls...)
+			singleData.fees = append(singleData.fees, item.fees...)
+			singleData.throughput = append(singleData.throughput, item.throughput...)
+			singleData.epochs = append(singleData.epochs, item.epochs...)
+			singleData.blocks = append(singleData.blocks, item.blocks...)
+			singleData.miniblocks = append(singleData.miniblocks, item.miniblocks...)
+			singleData.transactions = append(singleData.transactions, item.transactions...)
+			singleData.scResults = append(singleData.scResults, item.scResults...)
+			singleData.operations = append(singleData.operations, item.operations...)
+			singleData.accounts = append(singleData.accounts, item.accounts...)
+			singleData.esdtAccounts = append(singleData.esdtAccounts, item.esdtAccounts...)
 		}
 		p.updateStakeStates(singleData.stakeEvents)
 		p.wg.Add(1)
@@ -580,6 +663,70 @@ func (p *Parser) saving() {
 			log.Error("Parser: dao.CreateStakeEvents: %s", err.Error())
 			<-time.After(repeatDelay)
 		}
+		balanceChanges := mergeBalanceChanges(singleData.balanceChanges)
+		for {
+			err = p.dao.CreateBalanceChanges(balanceChanges)
+			if err == nil {
+				break
+			}
+			log.Error("Parser: dao.CreateBalanceChanges: %s", err.Error())
+			<-time.After(repeatDelay)
+		}
+		tokenTransfers := mergeTokenTransfers(singleData.tokenTransfers)
+		for {
+			err = p.dao.CreateTokenTransfers(tokenTransfers)
+			if err == nil {
+				break
+			}
+			log.Error("Parser: dao.CreateTokenTransfers: %s", err.Error())
+			<-time.After(repeatDelay)
+		}
+		changedTokens := uniqueStrings(singleData.changedTokens)
+		for {
+			err = p.dao.MarkTokensChanged(changedTokens, singleData.changedAt)
+			if err == nil {
+				break
+			}
+			log.Error("Parser: dao.MarkTokensChanged: %s", err.Error())
+			<-time.After(repeatDelay)
+		}
+		for {
+			err = p.dao.MarkTokensIssued(singleData.issuedTokens)
+			if err == nil {
+				break
+			}
+			log.Error("Parser: dao.MarkTokensIssued: %s", err.Error())
+			<-time.After(repeatDelay)
+		}
+		p.saveContracts(singleData)
+		for {
+			err = p.dao.CreateTxFees(singleData.fees)
+			if err == nil {
+				break
+			}
+			log.Error("Parser: dao.CreateTxFees: %s", err.Error())
+			<-time.After(repeatDelay)
+		}
+		throughput := mergeThroughput(singleData.throughput)
+		for {
+			err = p.dao.CreateShardThroughput(throughput)
+			if err == nil {
+				break
+			}
+			log.Error("Parser: dao.CreateShardThroughput: %s", err.Error())
+			<-time.After(repeatDelay)
+		}
+		for {
+			err = p.dao.CreateEpochs(singleData.epochs)
+			if err == nil {
+				break
+			}
+			log.Error("Parser: dao.CreateEpochs: %s", err.Error())
+			<-time.After(repeatDelay)
+		}
+		if p.indexing() {
+			p.saveIndex(singleData)
+		}
 
 		for {
 			model.Height += uint64(count)
@@ -590,11 +737,104 @@ func (p *Parser) saving() {
 			log.Error("Parser: dao.UpdateParserHeight: %s", err.Error())
 			<-time.After(repeatDelay)
 		}
-		dataset = append(dataset[count:])
+		dataset = dataset[count:]
 		p.wg.Done()
 	}
 }
 
+func (p *Parser) saveContracts(d data) {
+	var err error
+	for {
+		err = p.dao.CreateContracts(d.contracts)
+		if err == nil {
+			break
+		}
+		log.Error("Parser: dao.CreateContracts: %s", err.Error())
+		<-time.After(repeatDelay)
+	}
+	for _, c := range d.contractChanges {
+		for {
+			if c.UpgradeTxHash != "" {
+				err = p.dao.UpgradeContract(c)
+			} else {
+				err = p.dao.UpdateContractOwner(c)
+			}
+			if err == nil {
+				break
+			}
+			log.Error("Parser: saveContracts: %s", err.Error())
+			<-time.After(repeatDelay)
+		}
+	}
+	calls := mergeContractCalls(d.contractCalls)
+	called := make([]string, len(calls))
+	for i, c := range calls {
+		called[i] = c.Contract
+	}
+	for {
+		err = p.dao.CreateContractPlaceholders(uniqueStrings(called))
+		if err == nil {
+			break
+		}
+		log.Error("Parser: dao.CreateContractPlaceholders: %s", err.Error())
+		<-time.After(repeatDelay)
+	}
+	for {
+		err = p.dao.CreateContractCalls(calls)
+		if err == nil {
+			break
+		}
+		log.Error("Parser: dao.CreateContractCalls: %s", err.Error())
+		<-time.After(repeatDelay)
+	}
+}
+
+func (p *Parser) saveIndex(d data) {
+	var err error
+	for {
+		err = p.dao.CreateBlocks(d.blocks)
+		if err == nil {
+			break
+		}
+		log.Error("Parser: dao.CreateBlocks: %s", err.Error())
+		<-time.After(repeatDelay)
+	}
+	miniblocks := p.matchMiniblocks(d.miniblocks)
+	for {
+		err = p.dao.CreateMiniBlocks(miniblocks)
+		if err == nil {
+			break
+		}
+		log.Error("Parser: dao.CreateMiniBlocks: %s", err.Error())
+		<-time.After(repeatDelay)
+	}
+	for {
+		err = p.dao.CreateTransactions(d.transactions)
+		if err == nil {
+			break
+		}
+		log.Error("Parser: dao.CreateTransactions: %s", err.Error())
+		<-time.After(repeatDelay)
+	}
+	for {
+		err = p.dao.CreateSCResults(d.scResults)
+		if err == nil {
+			break
+		}
+		log.Error("Parser: dao.CreateSCResults: %s", err.Error())
+		<-time.After(repeatDelay)
+	}
+	for {
+		err = p.dao.CreateOperations(d.operations)
+		if err == nil {
+			break
+		}
+		log.Error("Parser: dao.CreateOperations: %s", err.Error())
+		<-time.After(repeatDelay)
+	}
+	p.updateIndexedAccounts(d.accounts, d.esdtAccounts)
+}
+
 func (p *Parser) matchMiniblocks(miniblocks []dmodels.MiniBlock) (result []dmodels.MiniBlock) {
 	mp := make(map[string]dmodels.MiniBlock)
 	for _, mb := range miniblocks {
@@ -24,21 +24,32 @@ func (p *Parser) updateStakeStates(events []dmodels.StakeEvent) {
 	p.mu.Lock()
 	for _, event := range events {
 		if event.Type == dmodels.DelegateStakeEventType || event.Type == dmodels.UnDelegateStakeEventType {
-			_, ok := p.delegations[event.Delegator]
-			if !ok {
-				p.delegations[event.Delegator] = make(map[string]decimal.Decimal)
-			}
-			v := p.delegations[event.Delegator][event.Validator]
-			amount := v.Add(event.Amount)
-			p.delegations[event.Delegator][event.Validator] = amount
-			if amount.IsZero() {
-				delete(p.delegations[event.Delegator], event.Validator)
-			}
+			p.addDelegation(event.Delegator, event.Validator, event.Amount)
 		}
 	}
 	p.mu.Unlock()
 }
 
+// CorrectDelegation adds the change of the reconciliation drift to the parsed stake of the delegator
+func (p *Parser) CorrectDelegation(delegator string, validator string, delta decimal.Decimal) {
+	p.mu.Lock()
+	p.addDelegation(delegator, validator, delta)
+	p.mu.Unlock()
+}
+
+func (p *Parser) addDelegation(delegator string, validator string, value decimal.Decimal) {
+	_, ok := p.delegations[delegator]
+	if !ok {
+		p.delegations[delegator] = make(map[string]decimal.Decimal)
+	}
+	v := p.delegations[delegator][validator]
+	amount := v.Add(value)
+	p.delegations[delegator][validator] = amount
+	if amount.IsZero() {
+		delete(p.delegations[delegator], validator)
+	}
+}
+
 func (p *Parser) GetDelegations(delegator string) map[string]decimal.Decimal {
 	res := make(map[string]decimal.Decimal)
 	p.mu.RLock()
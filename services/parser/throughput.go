@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"time"
+)
+
+// recordThroughput counts the shard block within the minute, the transactions are counted in the source shard only
+func (d *data) recordThroughput(block node.Block, t time.Time) {
+	item := dmodels.ShardThroughput{
+		Shard:     block.Shard,
+		Blocks:    1,
+		Size:      block.NumTxs,
+		Block:     d.height,
+		CreatedAt: t.Truncate(time.Minute),
+	}
+	if block.NumTxs == 0 {
+		item.EmptyBlocks = 1
+	}
+	for _, mb := range block.Miniblocks {
+		if mb.Type == txMiniblockType && mb.SourceShard == block.Shard {
+			item.Txs += uint64(len(mb.Transactions))
+		}
+	}
+	d.throughput = append(d.throughput, item)
+}
+
+// mergeThroughput sums the blocks of the shard within the minute and the hyperblock
+func mergeThroughput(items []dmodels.ShardThroughput) (result []dmodels.ShardThroughput) {
+	indexes := make(map[string]int)
+	for _, item := range items {
+		key := fmt.Sprintf("%d_%d_%d", item.Shard, item.CreatedAt.Unix(), item.Block)
+		i, ok := indexes[key]
+		if !ok {
+			indexes[key] = len(result)
+			result = append(result, item)
+			continue
+		}
+		result[i].Blocks += item.Blocks
+		result[i].EmptyBlocks += item.EmptyBlocks
+		result[i].Txs += item.Txs
+		result[i].Size += item.Size
+	}
+	return result
+}
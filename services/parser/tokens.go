@@ -0,0 +1,119 @@
+package parser
+
+import (
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var tokenIdentifierRegexp = regexp.MustCompile(`^[A-Za-z0-9]{3,20}-[0-9a-f]{6}$`)
+
+// esdtManagementFunctions are the functions of ESDT system contract which take the token identifier as the first argument
+var esdtManagementFunctions = map[string]bool{
+	"setSpecialRole":           true,
+	"unSetSpecialRole":         true,
+	"transferOwnership":        true,
+	"transferNFTCreateRole":    true,
+	"stopNFTCreate":            true,
+	"changeSFTToMetaESDT":      true,
+	"controlChanges":           true,
+	"pause":                    true,
+	"unPause":                  true,
+	"freeze":                   true,
+	"unFreeze":                 true,
+	"wipe":                     true,
+	"freezeSingleNFT":          true,
+	"unFreezeSingleNFT":        true,
+	"wipeSingleNFT":            true,
+	"mint":                     true,
+	"ESDTBurn":                 true,
+	"setBurnRoleGlobally":      true,
+	"unsetBurnRoleGlobally":    true,
+	"upgradeProperties":        true,
+	"changeToMultiShardCreate": true,
+}
+
+// esdtIssueFunctions are the functions of ESDT system contract which create a new token
+var esdtIssueFunctions = map[string]bool{
+	"issue":                  true,
+	"issueSemiFungible":      true,
+	"issueNonFungible":       true,
+	"registerMetaESDT":       true,
+	"registerAndSetAllRoles": true,
+}
+
+// supplyOperations change the supply of the token
+var supplyOperations = map[string]bool{
+	dmodels.ESDTLocalMintOperation:      true,
+	dmodels.ESDTLocalBurnOperation:      true,
+	dmodels.ESDTNFTCreateOperation:      true,
+	dmodels.ESDTNFTBurnOperation:        true,
+	dmodels.ESDTNFTAddQuantityOperation: true,
+}
+
+// recordTokenChanges collects tokens whose properties, roles or supply were changed by the tx,
+// identifiers of the issued tokens are taken from the results of ESDT system contract
+func (d *data) recordTokenChanges(tx node.Tx, txData string, esdtContract string, t time.Time) {
+	if strings.ToLower(tx.Status) != dmodels.TxStatusSuccess {
+		return
+	}
+	if tx.Receiver == esdtContract {
+		parts := strings.Split(txData, "@")
+		if esdtManagementFunctions[parts[0]] && len(parts) > 1 {
+			d.addChangedToken(hexToString(parts[1]), t)
+		}
+		for _, r := range tx.SmartContractResults {
+			for _, arg := range strings.Split(decodeSCRData(r.Data), "@") {
+				d.addChangedToken(hexToString(arg), t)
+				if esdtIssueFunctions[parts[0]] {
+					d.addIssuedToken(hexToString(arg), t)
+				}
+			}
+		}
+	}
+	datas := []string{txData}
+	for _, r := range tx.SmartContractResults {
+		datas = append(datas, decodeSCRData(r.Data))
+	}
+	for _, data := range datas {
+		op, ok := parseOperation(operationSource{sender: tx.Sender, receiver: tx.Receiver, data: data}, t)
+		if !ok || !supplyOperations[op.Operation] {
+			continue
+		}
+		for _, identifier := range op.Tokens {
+			token, _ := node.SplitTokenIdentifier(identifier)
+			d.addChangedToken(token, t)
+		}
+	}
+}
+
+func (d *data) addChangedToken(identifier string, t time.Time) {
+	if !tokenIdentifierRegexp.MatchString(identifier) {
+		return
+	}
+	d.changedTokens = append(d.changedTokens, identifier)
+	if t.After(d.changedAt) {
+		d.changedAt = t
+	}
+}
+
+func (d *data) addIssuedToken(identifier string, t time.Time) {
+	if !tokenIdentifierRegexp.MatchString(identifier) {
+		return
+	}
+	d.issuedTokens = append(d.issuedTokens, dmodels.TokenSync{Identifier: identifier, CreatedAt: &t})
+}
+
+func uniqueStrings(items []string) (result []string) {
+	seen := make(map[string]struct{})
+	for _, item := range items {
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		result = append(result, item)
+	}
+	return result
+}
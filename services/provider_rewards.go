@@ -0,0 +1,102 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+var realisedAPRWindows = []int{7, 30, 90}
+
+// saveProviderRewards snapshots the cumulated rewards and the active stake of the providers within the current epoch
+func (s *ServiceFacade) saveProviderRewards(providers []smodels.StakingProvider, nodesProviders map[string][]smodels.Node) error {
+	status, err := s.node.GetNetworkStatus(node.MetaChainShardIndex)
+	if err != nil {
+		return fmt.Errorf("node.GetNetworkStatus: %s", err.Error())
+	}
+	now := time.Now()
+	items := make([]dmodels.ProviderRewards, 0, len(providers))
+	for _, p := range providers {
+		nodes := nodesProviders[p.Provider]
+		if len(nodes) == 0 {
+			continue
+		}
+		locked, _ := ownerStake(nodes)
+		items = append(items, dmodels.ProviderRewards{
+			Provider:         p.Provider,
+			Epoch:            status.ErdEpochNumber,
+			CumulatedRewards: p.CumulatedRewards,
+			ActiveStake:      locked,
+			UpdatedAt:        now,
+		})
+	}
+	err = s.dao.UpdateProviderRewards(items)
+	if err != nil {
+		return fmt.Errorf("dao.UpdateProviderRewards: %s", err.Error())
+	}
+	return nil
+}
+
+// getRealisedAPR calculates the realised APR of the providers within the 7, 30 and 90 days windows
+func (s *ServiceFacade) getRealisedAPR() (map[string]smodels.RealisedAPR, error) {
+	epochDuration := time.Duration(s.networkConfig.ErdRoundsPerEpoch*s.networkConfig.ErdRoundDuration) * time.Millisecond
+	if epochDuration == 0 {
+		return nil, fmt.Errorf("epoch duration is zero")
+	}
+	maxWindow := realisedAPRWindows[len(realisedAPRWindows)-1]
+	items, err := s.dao.GetProviderEpochRewards(time.Now().AddDate(0, 0, -maxWindow))
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetProviderEpochRewards: %s", err.Error())
+	}
+	providersRewards := make(map[string][]dmodels.ProviderEpochRewards)
+	for _, item := range items {
+		providersRewards[item.Provider] = append(providersRewards[item.Provider], item)
+	}
+	epochsPerYear := decimal.New(int64(time.Hour*24*365), 0).Div(decimal.New(int64(epochDuration), 0))
+	result := make(map[string]smodels.RealisedAPR)
+	for provider, rewards := range providersRewards {
+		values := make([]decimal.Decimal, len(realisedAPRWindows))
+		for i, days := range realisedAPRWindows {
+			epochs := uint64(time.Duration(days) * time.Hour * 24 / epochDuration)
+			values[i] = realisedAPR(rewards, epochs, epochsPerYear)
+		}
+		result[provider] = smodels.RealisedAPR{
+			Week:    values[0],
+			Month:   values[1],
+			Quarter: values[2],
+		}
+	}
+	return result, nil
+}
+
+// realisedAPR annualises the rewards of the last epochs (the items are ordered by the epoch) to the average active stake
+func realisedAPR(items []dmodels.ProviderEpochRewards, epochs uint64, epochsPerYear decimal.Decimal) decimal.Decimal {
+	if len(items) == 0 {
+		return decimal.Zero
+	}
+	lastEpoch := items[len(items)-1].Epoch
+	var covered uint64
+	rewards, stake := decimal.Zero, decimal.Zero
+	count := 0
+	for _, item := range items {
+		if item.Epoch+epochs <= lastEpoch {
+			continue
+		}
+		covered += item.Epochs
+		rewards = rewards.Add(item.Rewards)
+		stake = stake.Add(item.ActiveStake)
+		count++
+	}
+	if covered == 0 || stake.IsZero() {
+		return decimal.Zero
+	}
+	avgStake := stake.Div(decimal.New(int64(count), 0))
+	return rewards.Div(avgStake).
+		Mul(epochsPerYear).
+		Div(decimal.New(int64(covered), 0)).
+		Mul(decimal.New(100, 0)).
+		Round(2)
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/shopspring/decimal"
+	"sort"
+	"time"
+)
+
+// queueSpeedEpochs is the number of the last epochs used to calculate the speed of the queue
+const queueSpeedEpochs = 10
+
+// saveQueueSnapshot stores the positions of the queued nodes within the current epoch
+func (s *ServiceFacade) saveQueueSnapshot(queue []node.QueueItem) error {
+	status, err := s.node.GetNetworkStatus(node.MetaChainShardIndex)
+	if err != nil {
+		return fmt.Errorf("node.GetNetworkStatus: %s", err.Error())
+	}
+	now := time.Now()
+	items := make([]dmodels.QueueSnapshot, len(queue))
+	for i, item := range queue {
+		items[i] = dmodels.QueueSnapshot{
+			Epoch:     status.ErdEpochNumber,
+			Key:       item.BLS,
+			Position:  item.Position,
+			UpdatedAt: now,
+		}
+	}
+	err = s.dao.UpdateQueueSnapshots(items)
+	if err != nil {
+		return fmt.Errorf("dao.UpdateQueueSnapshots: %s", err.Error())
+	}
+	return nil
+}
+
+// queueEstimator estimates the activation of the position from the average speed of the queue
+type queueEstimator struct {
+	epoch         smodels.Epoch
+	speed         decimal.Decimal
+	epochDuration time.Duration
+}
+
+func (s *ServiceFacade) getQueueEstimator() (estimator queueEstimator, err error) {
+	estimator.epoch, err = s.GetEpoch()
+	if err != nil {
+		return estimator, fmt.Errorf("GetEpoch: %s", err.Error())
+	}
+	movement, err := s.dao.GetQueueMovement(queueSpeedEpochs)
+	if err != nil {
+		return estimator, fmt.Errorf("dao.GetQueueMovement: %s", err.Error())
+	}
+	var left uint64
+	for _, m := range movement {
+		left += m.Left
+	}
+	if len(movement) > 0 {
+		estimator.speed = decimal.New(int64(left), 0).Div(decimal.New(int64(len(movement)), 0)).Round(2)
+	}
+	estimator.epochDuration = time.Duration(s.networkConfig.ErdRoundsPerEpoch*s.networkConfig.ErdRoundDuration) * time.Millisecond
+	return estimator, nil
+}
+
+func (e queueEstimator) estimate(position int64) smodels.NodeQueue {
+	q := smodels.NodeQueue{Position: position}
+	if !e.speed.IsPositive() {
+		return q
+	}
+	epochs := decimal.New(position, 0).Div(e.speed).Ceil().IntPart()
+	q.ActivationEpoch = e.epoch.EpochNumber + uint64(epochs)
+	t := smodels.NewTime(e.epoch.Start.Add(e.epochDuration * time.Duration(epochs)))
+	q.ActivationTime = &t
+	return q
+}
+
+// getNodeQueue returns the estimated activation and the positions history of the queued node
+func (s *ServiceFacade) getNodeQueue(n smodels.Node) (*smodels.NodeQueue, error) {
+	estimator, err := s.getQueueEstimator()
+	if err != nil {
+		return nil, fmt.Errorf("getQueueEstimator: %s", err.Error())
+	}
+	q := estimator.estimate(n.Position)
+	positions, err := s.dao.GetQueuePositions(n.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetQueuePositions: %s", err.Error())
+	}
+	for _, p := range positions {
+		q.Positions = append(q.Positions, smodels.QueuePosition{Epoch: p.Epoch, Position: p.Position})
+	}
+	return &q, nil
+}
+
+func (s *ServiceFacade) GetQueue() (queue smodels.Queue, err error) {
+	var nodes []smodels.Node
+	err = s.getCache(dmodels.NodesStorageKey, &nodes)
+	if err != nil {
+		return queue, fmt.Errorf("getCache: %s", err.Error())
+	}
+	estimator, err := s.getQueueEstimator()
+	if err != nil {
+		return queue, fmt.Errorf("getQueueEstimator: %s", err.Error())
+	}
+	queue = smodels.Queue{
+		Epoch: estimator.epoch.EpochNumber,
+		Speed: estimator.speed,
+		Nodes: []smodels.QueueNode{},
+	}
+	for _, n := range nodes {
+		if n.Status != smodels.NodeStatusQueued {
+			continue
+		}
+		queue.Nodes = append(queue.Nodes, smodels.QueueNode{
+			PublicKey: n.PublicKey,
+			Owner:     n.Owner,
+			Provider:  n.Provider,
+			NodeQueue: estimator.estimate(n.Position),
+		})
+	}
+	sort.Slice(queue.Nodes, func(i, j int) bool {
+		return queue.Nodes[i].Position < queue.Nodes[j].Position
+	})
+	return queue, nil
+}
@@ -3,11 +3,16 @@ package services
 import (
 	"fmt"
 	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
 	"github.com/everstake/elrond-monitor-backend/log"
 	"github.com/everstake/elrond-monitor-backend/smodels"
 	"github.com/shopspring/decimal"
+	"time"
 )
 
+// defaultRankingBuckets are the lower boundaries of the ranking buckets in EGLD (the first bucket starts from zero)
+var defaultRankingBuckets = []float64{100, 1000, 10000, 100000}
+
 func (s *ServiceFacade) GetRanking() (items []smodels.Ranking, err error) {
 	err = s.getCache(dmodels.RankingStorageKey, &items)
 	if err != nil {
@@ -16,75 +21,178 @@ func (s *ServiceFacade) GetRanking() (items []smodels.Ranking, err error) {
 	return items, nil
 }
 
-func (s *ServiceFacade) MakeRanking() {
-	err := s.makeRanking()
+func (s *ServiceFacade) GetCustomRanking(filter filters.Ranking) (items []smodels.CustomRanking, err error) {
+	boundaries := rankingBoundaries(filter.Buckets)
+	buckets, err := s.dao.GetRankingBuckets(boundaries[1:])
 	if err != nil {
-		log.Error("makeRanking: %s", err.Error())
+		return nil, fmt.Errorf("dao.GetRankingBuckets: %s", err.Error())
 	}
+	items, err = s.rankProviders(boundaries, buckets)
+	if err != nil {
+		return nil, fmt.Errorf("rankProviders: %s", err.Error())
+	}
+	return items, nil
 }
-func (s *ServiceFacade) makeRanking() error {
-	delegations, err := s.dao.GetDelegationState()
+
+// rankProviders splits the stake of every provider by the buckets, the stake which isn't tracked
+// by the delegations is counted in the first bucket
+func (s *ServiceFacade) rankProviders(boundaries []decimal.Decimal, buckets []dmodels.RankingBucket) (items []smodels.CustomRanking, err error) {
+	providersBuckets := make(map[string][]dmodels.RankingBucket)
+	for _, b := range buckets {
+		providersBuckets[b.Provider] = append(providersBuckets[b.Provider], b)
+	}
+	var providers []smodels.StakingProvider
+	err = s.getCache(dmodels.StakingProvidersStorageKey, &providers)
 	if err != nil {
-		return fmt.Errorf("dao.GetDelegationState: %s", err.Error())
+		return nil, fmt.Errorf("getCache: %s", err.Error())
 	}
-	delegationsMap := make(map[string]map[string]decimal.Decimal) // [provider][delegator]amount
-	for _, delegation := range delegations {
-		if delegation.Amount.IsZero() {
+	for _, p := range providers {
+		rank := toRankingBuckets(boundaries, providersBuckets[p.Provider])
+		total := decimal.Zero
+		for _, b := range rank {
+			total = total.Add(b.Amount)
+		}
+		if total.GreaterThan(p.Locked) {
+			log.Warn("rankProviders: provider[%s, %s]: total > locked", p.Name, p.Provider)
 			continue
 		}
-		if _, ok := delegationsMap[delegation.Validator]; !ok {
-			delegationsMap[delegation.Validator] = make(map[string]decimal.Decimal)
+		rank[0].Amount = rank[0].Amount.Add(p.Locked.Sub(total))
+		items = append(items, smodels.CustomRanking{
+			Name:    p.Name,
+			Address: p.Provider,
+			Buckets: rank,
+		})
+	}
+	return items, nil
+}
+
+func (s *ServiceFacade) GetRankingHistory(filter filters.RankingHistory) (items []smodels.RankingHistory, err error) {
+	history, err := s.dao.GetRankingHistory(filter)
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetRankingHistory: %s", err.Error())
+	}
+	boundaries := rankingBoundaries(defaultRankingBuckets)
+	for i := 0; i < len(history); {
+		j := i
+		for j < len(history) && history[j].Day.Equal(history[i].Day) {
+			j++
 		}
-		delegationsMap[delegation.Validator][delegation.Delegator] = delegation.Amount
+		items = append(items, smodels.RankingHistory{
+			Day:     smodels.NewTime(history[i].Day),
+			Buckets: toRankingBuckets(boundaries, history[i:j]),
+		})
+		i = j
 	}
+	return items, nil
+}
 
-	var providers []smodels.StakingProvider
-	err = s.getCache(dmodels.StakingProvidersStorageKey, &providers)
+func (s *ServiceFacade) GetProviderDelegators(filter filters.ProviderDelegators) (page smodels.Pagination, err error) {
+	delegators, err := s.dao.GetProviderDelegators(filter)
+	if err != nil {
+		return page, fmt.Errorf("dao.GetProviderDelegators: %s", err.Error())
+	}
+	total, err := s.dao.GetProviderDelegatorsTotal(filter)
 	if err != nil {
-		return fmt.Errorf("getCache: %s", err.Error())
+		return page, fmt.Errorf("dao.GetProviderDelegatorsTotal: %s", err.Error())
 	}
+	items := make([]smodels.Delegator, len(delegators))
+	for i, d := range delegators {
+		items[i] = smodels.Delegator{
+			Address: d.Delegator,
+			Amount:  d.Amount,
+		}
+	}
+	return smodels.Pagination{
+		Items: items,
+		Count: total,
+	}, nil
+}
 
-	if len(providers) > 100 {
-		providers = providers[:100]
+func (s *ServiceFacade) MakeRanking() {
+	err := s.makeRanking()
+	if err != nil {
+		log.Error("makeRanking: %s", err.Error())
 	}
+}
 
-	var ranking []smodels.Ranking
-	for _, p := range providers {
-		rank := smodels.Ranking{Name: p.Name, Address: p.Provider}
-		total := decimal.Zero
-		for _, amount := range delegationsMap[p.Provider] {
-			switch true {
-			case amount.GreaterThanOrEqual(decimal.Zero) && amount.LessThan(intToDec(100)):
-				rank.T100.Count++
-			case amount.GreaterThanOrEqual(intToDec(100)) && amount.LessThan(intToDec(1000)):
-				rank.F100T1k.Amount = rank.F100T1k.Amount.Add(amount)
-				rank.F100T1k.Count++
-			case amount.GreaterThanOrEqual(intToDec(1000)) && amount.LessThan(intToDec(10000)):
-				rank.F1kT10k.Amount = rank.F1kT10k.Amount.Add(amount)
-				rank.F1kT10k.Count++
-			case amount.GreaterThanOrEqual(intToDec(10000)) && amount.LessThan(intToDec(100000)):
-				rank.F10kT100k.Amount = rank.F10kT100k.Amount.Add(amount)
-				rank.F10kT100k.Count++
-			case amount.GreaterThanOrEqual(intToDec(100000)):
-				rank.F100k.Amount = rank.F100k.Amount.Add(amount)
-				rank.F100k.Count++
-			}
-			total = total.Add(amount)
-		}
-		if total.GreaterThan(p.Locked) {
-			log.Warn("makeRanking: provider[%s, %s]: total > locked", p.Name, p.Provider)
-			continue
+// makeRanking caches the ranking with the default buckets and persists it as the ranking of the day
+func (s *ServiceFacade) makeRanking() error {
+	boundaries := rankingBoundaries(defaultRankingBuckets)
+	buckets, err := s.dao.GetRankingBuckets(boundaries[1:])
+	if err != nil {
+		return fmt.Errorf("dao.GetRankingBuckets: %s", err.Error())
+	}
+	customRanking, err := s.rankProviders(boundaries, buckets)
+	if err != nil {
+		return fmt.Errorf("rankProviders: %s", err.Error())
+	}
+	ranking := make([]smodels.Ranking, len(customRanking))
+	for i, r := range customRanking {
+		ranking[i] = smodels.Ranking{
+			Name:      r.Name,
+			Address:   r.Address,
+			T100:      toRankingRange(r.Buckets[0]),
+			F100T1k:   toRankingRange(r.Buckets[1]),
+			F1kT10k:   toRankingRange(r.Buckets[2]),
+			F10kT100k: toRankingRange(r.Buckets[3]),
+			F100k:     toRankingRange(r.Buckets[4]),
 		}
-		rank.T100.Amount = p.Locked.Sub(total)
-		ranking = append(ranking, rank)
 	}
 	err = s.setCache(dmodels.RankingStorageKey, ranking)
 	if err != nil {
 		return fmt.Errorf("setCache: %s", err.Error())
 	}
+	day := time.Now().Truncate(time.Hour * 24)
+	for i := range buckets {
+		buckets[i].Day = day
+	}
+	err = s.dao.CreateRankingHistory(buckets)
+	if err != nil {
+		return fmt.Errorf("dao.CreateRankingHistory: %s", err.Error())
+	}
 	return nil
 }
 
+// rankingBoundaries returns the lower boundaries of all the buckets including zero
+func rankingBoundaries(buckets []float64) []decimal.Decimal {
+	if len(buckets) == 0 {
+		buckets = defaultRankingBuckets
+	}
+	boundaries := []decimal.Decimal{decimal.Zero}
+	for _, b := range buckets {
+		boundaries = append(boundaries, decimal.NewFromFloat(b))
+	}
+	return boundaries
+}
+
+func toRankingBuckets(boundaries []decimal.Decimal, items []dmodels.RankingBucket) []smodels.RankingBucket {
+	buckets := make([]smodels.RankingBucket, len(boundaries))
+	for i, from := range boundaries {
+		buckets[i].From = from
+		if i+1 < len(boundaries) {
+			to := boundaries[i+1]
+			buckets[i].To = &to
+		}
+	}
+	for _, item := range items {
+		for i := range buckets {
+			if buckets[i].From.Equal(item.From) {
+				buckets[i].Count += item.Count
+				buckets[i].Amount = buckets[i].Amount.Add(item.Amount)
+				break
+			}
+		}
+	}
+	return buckets
+}
+
+func toRankingRange(bucket smodels.RankingBucket) smodels.RankingRange {
+	return smodels.RankingRange{
+		Amount: bucket.Amount,
+		Count:  bucket.Count,
+	}
+}
+
 func intToDec(v int64) decimal.Decimal {
 	return decimal.New(v, 0)
 }
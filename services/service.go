@@ -5,6 +5,9 @@ import (
 	"github.com/everstake/elrond-monitor-backend/config"
 	"github.com/everstake/elrond-monitor-backend/dao"
 	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/services/abi"
+	"github.com/everstake/elrond-monitor-backend/services/identity"
+	"github.com/everstake/elrond-monitor-backend/services/nftmeta"
 	"github.com/everstake/elrond-monitor-backend/services/node"
 	"github.com/everstake/elrond-monitor-backend/smodels"
 	"github.com/shopspring/decimal"
@@ -20,45 +23,93 @@ type (
 		GetAccounts(filter filters.Accounts) (items smodels.Pagination, err error)
 		GetMiniBlock(hash string) (block smodels.Miniblock, err error)
 		GetAccount(address string) (account smodels.Account, err error)
+		GetBalanceHistory(filter filters.BalanceHistory) (items []smodels.RangeItem, err error)
+		GetAccountUnbonding(address string) (unbonding smodels.AccountUnbonding, err error)
+		GetUnbondingStats() (stats smodels.UnbondingStats, err error)
+		GetStakeSourcesHistory(filter filters.StakeSourcesRange) (items []smodels.StakeBreakdownItem, err error)
 		UpdateNodes()
 		GetNodes(filter filters.Nodes) (nodes smodels.Pagination, err error)
 		UpdateStats()
 		GetStats() (stats smodels.Stats, err error)
+		UpdateDistribution()
+		GetDistribution() (distribution smodels.Distribution, err error)
 		GetDailyStats(filter filters.DailyStats) (items []smodels.RangeItem, err error)
+		GetTopFeeContracts(filter filters.DailyStats) (items []smodels.ContractFees, err error)
+		GetTPS() (tps smodels.TPS, err error)
+		GetThroughputRange(filter filters.Throughput) (items []smodels.Throughput, err error)
+		GetShardsThroughput(filter filters.Throughput) (items []smodels.ShardThroughput, err error)
 		GetEpoch() (epoch smodels.Epoch, err error)
+		UpdateEpochSnapshot()
+		GetEpochs(filter filters.Epochs) (items smodels.Pagination, err error)
+		GetEpochSummary(epoch uint64) (summary smodels.EpochSummary, err error)
 		UpdateValidatorsMap()
 		GetValidatorsMap() ([]byte, error)
 		GetStakeEvents(filter filters.StakeEvents) (items smodels.Pagination, err error)
+		GetStakeFlows(filter filters.StakeFlows) (items []smodels.StakeFlow, err error)
 		GetStakingProviders(filter filters.StakingProviders) (pagination smodels.Pagination, err error)
 		GetStakingProvider(address string) (provider smodels.StakingProvider, err error)
 		UpdateStakingProviders()
+		GetStakingCalculation(amount decimal.Decimal, provider string) (calc smodels.StakingCalculation, err error)
 		GetNode(key string) (node smodels.Node, err error)
+		GetQueue() (queue smodels.Queue, err error)
+		UpdateValidatorEpochs()
 		UpdateValidators()
 		GetValidators(filter filters.Validators) (pagination smodels.Pagination, err error)
 		GetValidator(identity string) (validator smodels.Identity, err error)
 		GetValidatorStats() (stats smodels.ValidatorStats, err error)
+		SetIdentityProfile(identity string, profile smodels.IdentityProfile) error
+		DeleteIdentityProfile(identity string) error
 		MakeRanking()
 		GetRanking() (items []smodels.Ranking, err error)
+		GetCustomRanking(filter filters.Ranking) (items []smodels.CustomRanking, err error)
+		GetRankingHistory(filter filters.RankingHistory) (items []smodels.RankingHistory, err error)
+		GetProviderDelegators(filter filters.ProviderDelegators) (page smodels.Pagination, err error)
+		ReconcileDelegations()
+		GetDelegationDrifts(filter filters.DelegationDrifts) (page smodels.Pagination, err error)
 		UpdateTokens()
+		UpdateNFTMetadata()
+		UpdateNFTCollectionsStats()
 		GetToken(id string) (token smodels.Token, err error)
+		GetTokenHolders(filter filters.ESDT) (pagination smodels.Pagination, err error)
+		GetTokenDailyStats(filter filters.TokenDailyStats) (items []smodels.RangeItem, err error)
+		GetTokenSupplyRange(filter filters.TokenDailyStats) (items []smodels.RangeItem, err error)
+		GetTokenMints(filter filters.Operations) (items smodels.Pagination, err error)
+		GetContracts(filter filters.Contracts) (items smodels.Pagination, err error)
+		GetContract(address string) (contract smodels.Contract, err error)
+		GetContractCalls(filter filters.ContractCalls) (items smodels.Pagination, err error)
+		GetContractABI(address string) (contractABI abi.ABI, err error)
+		UpdateContractABI(address string, raw []byte) error
 		GetTokens(filter filters.Tokens) (pagination smodels.Pagination, err error)
+		GetTokensSync() (metrics smodels.TokensSync, err error)
 		GetNFTCollection(id string) (collection smodels.NFTCollection, err error)
 		GetNFTCollections(filter filters.NFTCollections) (pagination smodels.Pagination, err error)
+		GetNFTCollectionStats(filter filters.NFTCollectionStats) (stats smodels.NFTCollectionStats, err error)
 		GetNFT(id string) (sNFT smodels.NFT, err error)
+		GetNFTThumbnail(identifier string) (path string, err error)
+		GetNFTTransfers(filter filters.Operations) (items smodels.Pagination, err error)
+		GetAccountNFTs(filter filters.ESDT) (items smodels.Pagination, err error)
 		GetNFTs(filter filters.NFTTokens) (pagination smodels.Pagination, err error)
 		GetOperations(filter filters.Operations) (items smodels.Pagination, err error)
 		GetESDTAccounts(filter filters.ESDT) (items smodels.Pagination, err error)
 	}
 	parser interface {
 		GetDelegations(delegator string) map[string]decimal.Decimal
+		CorrectDelegation(delegator string, validator string, delta decimal.Decimal)
 	}
 
 	ServiceFacade struct {
-		dao           dao.DAO
-		cfg           config.Config
-		node          node.APIi
-		networkConfig node.NetworkConfig
-		parser        parser
+		dao            dao.DAO
+		cfg            config.Config
+		node           node.APIi
+		networkConfig  node.NetworkConfig
+		parser         parser
+		nftResolver    *nftmeta.Resolver
+		abis           *abi.Registry
+		identities     *identity.Resolver
+		identitiesFile *identity.FileSource
+
+		tokensSyncRunning       int32
+		validatorsUpdateRunning int32
 	}
 )
 
@@ -68,11 +119,20 @@ func NewServices(d dao.DAO, cfg config.Config, p parser) (svc Services, err erro
 	if err != nil {
 		return nil, fmt.Errorf("GetNetworkConfig: %s", err.Error())
 	}
-	return &ServiceFacade{
-		dao:           d,
-		cfg:           cfg,
-		node:          n,
-		networkConfig: nCfg,
-		parser:        p,
-	}, nil
+	s := &ServiceFacade{
+		dao:            d,
+		cfg:            cfg,
+		node:           n,
+		networkConfig:  nCfg,
+		parser:         p,
+		nftResolver:    nftmeta.NewResolver(cfg.NFTMetadata.IPFSGateway),
+		abis:           newABIRegistry(cfg.Contracts),
+		identities:     newIdentityResolver(),
+		identitiesFile: identity.NewFileSource(cfg.Identities.OverridesFile),
+	}
+	err = s.loadContractABIs()
+	if err != nil {
+		return nil, fmt.Errorf("loadContractABIs: %s", err.Error())
+	}
+	return s, nil
 }
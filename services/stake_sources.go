@@ -0,0 +1,109 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/services/dailystats"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"sort"
+	"time"
+)
+
+// getStakeBreakdown returns the current stake of the address (or the network if the address is empty) by the sources
+func (s *ServiceFacade) getStakeBreakdown(address string) (stake smodels.StakeBreakdown, err error) {
+	items, err := s.dao.GetStakeSources(filters.StakeSources{
+		Delegator: address,
+		Legacy:    s.cfg.Contracts.Delegation,
+		Direct:    []string{s.cfg.Contracts.Auction, s.cfg.Contracts.Staking},
+	})
+	if err != nil {
+		return stake, fmt.Errorf("dao.GetStakeSources: %s", err.Error())
+	}
+	for _, item := range items {
+		setSourceStake(&stake, item)
+	}
+	return stake, nil
+}
+
+// GetStakeSourcesHistory returns the daily stake of the address (or the network if the address is empty) by the sources
+func (s *ServiceFacade) GetStakeSourcesHistory(filter filters.StakeSourcesRange) (items []smodels.StakeBreakdownItem, err error) {
+	if filter.Address == "" {
+		return s.getNetworkStakeSourcesHistory(filter)
+	}
+	history, err := s.dao.GetStakeSourcesHistory(filters.StakeSources{
+		Delegator: filter.Address,
+		Legacy:    s.cfg.Contracts.Delegation,
+		Direct:    []string{s.cfg.Contracts.Auction, s.cfg.Contracts.Staking},
+		To:        filter.To,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetStakeSourcesHistory: %s", err.Error())
+	}
+	day := filter.From.Truncate(time.Hour * 24)
+	var stake smodels.StakeBreakdown
+	i := 0
+	// the days without the events keep the stake of the previous day
+	for ; day.Before(filter.To.Time); day = day.Add(time.Hour * 24) {
+		for ; i < len(history) && !history[i].Day.After(day); i++ {
+			setSourceStake(&stake, history[i])
+		}
+		items = append(items, smodels.StakeBreakdownItem{
+			Day:            smodels.NewTime(day),
+			StakeBreakdown: stake,
+		})
+	}
+	return items, nil
+}
+
+// getNetworkStakeSourcesHistory merges the daily stats of the sources collected by the daily stats job
+func (s *ServiceFacade) getNetworkStakeSourcesHistory(filter filters.StakeSourcesRange) (items []smodels.StakeBreakdownItem, err error) {
+	sources := map[string]string{
+		dailystats.StakeDirectKey:    dmodels.DirectStakeSource,
+		dailystats.StakeLegacyKey:    dmodels.LegacyStakeSource,
+		dailystats.StakeProvidersKey: dmodels.ProvidersStakeSource,
+	}
+	days := make(map[time.Time]*smodels.StakeBreakdown)
+	var order []time.Time
+	for key, source := range sources {
+		stats, err := s.dao.GetDailyStatsRange(filters.DailyStats{
+			Key:  key,
+			From: filter.From,
+			To:   filter.To,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("dao.GetDailyStatsRange(%s): %s", key, err.Error())
+		}
+		for _, stat := range stats {
+			stake, ok := days[stat.CreatedAt]
+			if !ok {
+				stake = &smodels.StakeBreakdown{}
+				days[stat.CreatedAt] = stake
+				order = append(order, stat.CreatedAt)
+			}
+			setSourceStake(stake, dmodels.SourceStake{Source: source, Amount: stat.Value})
+		}
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return order[i].Before(order[j])
+	})
+	items = make([]smodels.StakeBreakdownItem, len(order))
+	for i, day := range order {
+		items[i] = smodels.StakeBreakdownItem{
+			Day:            smodels.NewTime(day),
+			StakeBreakdown: *days[day],
+		}
+	}
+	return items, nil
+}
+
+func setSourceStake(stake *smodels.StakeBreakdown, item dmodels.SourceStake) {
+	switch item.Source {
+	case dmodels.DirectStakeSource:
+		stake.Direct = item.Amount
+	case dmodels.LegacyStakeSource:
+		stake.Legacy = item.Amount
+	case dmodels.ProvidersStakeSource:
+		stake.Providers = item.Amount
+	}
+}
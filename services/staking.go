@@ -6,6 +6,7 @@ import (
 	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
 	"github.com/everstake/elrond-monitor-backend/dao/filters"
 	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/services/abi"
 	"github.com/everstake/elrond-monitor-backend/services/node"
 	"github.com/everstake/elrond-monitor-backend/smodels"
 	"github.com/shopspring/decimal"
@@ -41,6 +42,27 @@ func (s *ServiceFacade) GetStakeEvents(filter filters.StakeEvents) (page smodels
 	}, nil
 }
 
+func (s *ServiceFacade) GetStakeFlows(filter filters.StakeFlows) (items []smodels.StakeFlow, err error) {
+	flows, err := s.dao.GetStakeFlows(filter)
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetStakeFlows: %s", err.Error())
+	}
+	items = make([]smodels.StakeFlow, len(flows))
+	for i, flow := range flows {
+		items[i] = smodels.StakeFlow{
+			Day:               smodels.NewTime(flow.Day),
+			Inflow:            flow.Inflow,
+			Outflow:           flow.Outflow,
+			Redelegated:       flow.Redelegated,
+			Withdrawn:         flow.Withdrawn,
+			NetFlow:           flow.Inflow.Sub(flow.Outflow),
+			NewDelegators:     flow.NewDelegators,
+			LeavingDelegators: flow.LeavingDelegators,
+		}
+	}
+	return items, nil
+}
+
 func (s *ServiceFacade) GetStakingProviders(filter filters.StakingProviders) (pagination smodels.Pagination, err error) {
 	var providers []smodels.StakingProvider
 	err = s.getCache(dmodels.StakingProvidersStorageKey, &providers)
@@ -91,6 +113,11 @@ func (s *ServiceFacade) updateStakingProviders() error {
 	if err != nil {
 		return fmt.Errorf("node.GetProviderAddresses: %s", err.Error())
 	}
+	for _, address := range addresses {
+		if _, ok := s.abis.Get(address); !ok {
+			s.abis.Set(address, abi.Delegation)
+		}
+	}
 	sourceProviders, err := s.getStakingProvidersFromSource()
 	if err != nil {
 		log.Error("updateStakingProviders: getStakingProvidersFromSource: %s", err.Error())
@@ -110,6 +137,10 @@ func (s *ServiceFacade) updateStakingProviders() error {
 			nodesProviders[n.Owner] = append(nodesProviders[n.Owner], n)
 		}
 	}
+	network, err := s.aprNetwork(nodes)
+	if err != nil {
+		log.Error("updateStakingProviders: aprNetwork: %s", err.Error())
+	}
 	var identities []smodels.Identity
 	err = s.getCache(dmodels.ValidatorsStorageKey, &identities)
 	if err != nil {
@@ -153,7 +184,9 @@ func (s *ServiceFacade) updateStakingProviders() error {
 			ServiceFee:       config.ServiceFee.Div(decimal.New(100, 0)),
 			DelegationCap:    node.ValueToEGLD(config.DelegationCap),
 			APR:              sp.Apr,
+			SourceAPR:        sp.Apr,
 			NumUsers:         numUsers,
+			UnBondPeriod:     sp.UnBondPeriod,
 			CumulatedRewards: node.ValueToEGLD(reward),
 			Identity:         meta.Iidentity,
 			Featured:         sp.Featured,
@@ -172,6 +205,12 @@ func (s *ServiceFacade) updateStakingProviders() error {
 		if p.NumNodes > 0 {
 			p.AVGUptime = totalUptime / float64(p.NumNodes)
 		}
+		if network.Nodes > 0 {
+			p.APR = network.APR(aprValidator(p, nodesProviders[address]))
+		}
+		if p.UnBondPeriod == 0 {
+			p.UnBondPeriod = s.cfg.Staking.UnBondPeriod
+		}
 		if p.NumNodes == 0 || p.Stake.Equal(decimal.Zero) {
 			continue
 		}
@@ -180,6 +219,17 @@ func (s *ServiceFacade) updateStakingProviders() error {
 	sort.Slice(providers, func(i, j int) bool {
 		return providers[i].Locked.GreaterThan(providers[j].Locked)
 	})
+	err = s.saveProviderRewards(providers, nodesProviders)
+	if err != nil {
+		log.Error("updateStakingProviders: saveProviderRewards: %s", err.Error())
+	}
+	realisedAPR, err := s.getRealisedAPR()
+	if err != nil {
+		log.Error("updateStakingProviders: getRealisedAPR: %s", err.Error())
+	}
+	for i, p := range providers {
+		providers[i].RealisedAPR = realisedAPR[p.Provider]
+	}
 	err = s.setCache(dmodels.StakingProvidersStorageKey, providers)
 	if err != nil {
 		return fmt.Errorf("setCache: %s", err.Error())
@@ -11,6 +11,7 @@ import (
 	"github.com/shopspring/decimal"
 	"io/ioutil"
 	"net/http"
+	"time"
 )
 
 const validatorsMapSource = "https://internal-api.elrond.com/markers"
@@ -75,19 +76,18 @@ func (s *ServiceFacade) updateStats() error {
 	if len(providers) > 0 {
 		avgFee = avgFee.Div(decimal.New(int64(len(providers)), 0))
 	}
-	txs, err := s.dao.GetTransactions(filters.Transactions{
-		Pagination: filters.Pagination{Limit: 100},
-	})
+	now := time.Now()
+	fees, err := s.dao.GetFeeStats(now.Add(-time.Hour*24), now)
 	if err != nil {
-		return fmt.Errorf("dao.GetTransactions: %s", err.Error())
+		return fmt.Errorf("dao.GetFeeStats: %s", err.Error())
 	}
 	avgTxFee := decimal.Zero
-	for _, tx := range txs {
-		f, _ := decimal.NewFromString(tx.Fee)
-		avgTxFee = avgTxFee.Add(f)
+	if fees.Count > 0 {
+		avgTxFee = fees.Total.Div(decimal.New(int64(fees.Count), 0))
 	}
-	if len(txs) > 0 {
-		avgTxFee = avgTxFee.Div(decimal.New(int64(len(txs)), 0))
+	stake, err := s.getStakeBreakdown("")
+	if err != nil {
+		return fmt.Errorf("getStakeBreakdown: %s", err.Error())
 	}
 	err = s.setCache(dmodels.StatsStorageKey, smodels.Stats{
 		Price:                  marketData.Price,
@@ -103,6 +103,7 @@ func (s *ServiceFacade) updateStats() error {
 		StakingProviders:       uint64(len(providers)),
 		AVGStakingProvidersFee: avgFee,
 		AVGTxFee:               node.ValueToEGLD(avgTxFee),
+		Stake:                  stake,
 	})
 	if err != nil {
 		return fmt.Errorf("setCache: %s", err.Error())
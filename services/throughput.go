@@ -0,0 +1,91 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/dao/postgres"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// GetTPS returns TPS of the last completed minute and the peak one
+func (s *ServiceFacade) GetTPS() (tps smodels.TPS, err error) {
+	items, err := s.dao.GetLatestThroughput(2)
+	if err != nil {
+		return tps, fmt.Errorf("dao.GetLatestThroughput: %s", err.Error())
+	}
+	// the latest minute is still being parsed, so the previous one is needed
+	if len(items) < 2 {
+		return tps, nil
+	}
+	last := items[1]
+	tps.TPS = calcTPS(last.Txs, time.Minute)
+	tps.Time = smodels.NewTime(last.CreatedAt)
+	peak, err := s.dao.GetPeakThroughput(filters.Throughput{})
+	if err != nil {
+		if err.Error() == postgres.NoRowsError {
+			return tps, nil
+		}
+		return tps, fmt.Errorf("dao.GetPeakThroughput: %s", err.Error())
+	}
+	tps.PeakTPS = calcTPS(peak.Txs, time.Minute)
+	tps.PeakTime = smodels.NewTime(peak.CreatedAt)
+	return tps, nil
+}
+
+// GetThroughputRange returns the network (or the chosen shards) throughput per minute
+func (s *ServiceFacade) GetThroughputRange(filter filters.Throughput) (items []smodels.Throughput, err error) {
+	dItems, err := s.dao.GetThroughputRange(filter)
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetThroughputRange: %s", err.Error())
+	}
+	items = make([]smodels.Throughput, len(dItems))
+	for i, it := range dItems {
+		items[i] = toThroughput(it, time.Minute)
+	}
+	return items, nil
+}
+
+// GetShardsThroughput returns the throughput of every shard within the range
+func (s *ServiceFacade) GetShardsThroughput(filter filters.Throughput) (items []smodels.ShardThroughput, err error) {
+	dItems, err := s.dao.GetShardsThroughput(filter)
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetShardsThroughput: %s", err.Error())
+	}
+	period := filter.To.Sub(filter.From.Time)
+	items = make([]smodels.ShardThroughput, len(dItems))
+	for i, it := range dItems {
+		items[i] = smodels.ShardThroughput{
+			Shard:      it.Shard,
+			Throughput: toThroughput(it, period),
+		}
+	}
+	return items, nil
+}
+
+func toThroughput(item dmodels.ShardThroughput, period time.Duration) smodels.Throughput {
+	t := smodels.Throughput{
+		Blocks:           item.Blocks,
+		EmptyBlocks:      item.EmptyBlocks,
+		EmptyBlocksRatio: decimal.Zero,
+		AVGBlockSize:     decimal.Zero,
+		Txs:              item.Txs,
+		TPS:              calcTPS(item.Txs, period),
+		Time:             smodels.NewTime(item.CreatedAt),
+	}
+	if item.Blocks > 0 {
+		blocks := decimal.New(int64(item.Blocks), 0)
+		t.EmptyBlocksRatio = decimal.New(int64(item.EmptyBlocks), 0).Div(blocks)
+		t.AVGBlockSize = decimal.New(int64(item.Size), 0).Div(blocks)
+	}
+	return t
+}
+
+func calcTPS(txs uint64, period time.Duration) decimal.Decimal {
+	if period < time.Second {
+		return decimal.Zero
+	}
+	return decimal.New(int64(txs), 0).Div(decimal.NewFromFloat(period.Seconds())).Round(2)
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/services/dailystats"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/shopspring/decimal"
+)
+
+// GetTokenHolders returns holders of the token ordered by balance with their share of the supply
+func (s *ServiceFacade) GetTokenHolders(filter filters.ESDT) (pagination smodels.Pagination, err error) {
+	token, err := s.dao.GetToken(filter.TokenIdentifier)
+	if err != nil {
+		return pagination, fmt.Errorf("dao.GetToken: %s", err.Error())
+	}
+	accounts, err := s.dao.GetESDTAccounts(filter)
+	if err != nil {
+		return pagination, fmt.Errorf("dao.GetESDTAccounts: %s", err.Error())
+	}
+	total, err := s.dao.GetESDTAccountsCount(filter)
+	if err != nil {
+		return pagination, fmt.Errorf("dao.GetESDTAccountsCount: %s", err.Error())
+	}
+	holders := make([]smodels.TokenHolder, len(accounts))
+	for i, acc := range accounts {
+		holders[i] = smodels.TokenHolder{
+			Address: acc.Address,
+			Balance: acc.Balance.Shift(-int32(token.Decimals)),
+		}
+		if token.Supply.IsPositive() {
+			holders[i].Share = holders[i].Balance.Div(token.Supply).Mul(decimal.New(100, 0))
+		}
+	}
+	return smodels.Pagination{
+		Items: holders,
+		Count: total,
+	}, nil
+}
+
+func (s *ServiceFacade) GetTokenDailyStats(filter filters.TokenDailyStats) (items []smodels.RangeItem, err error) {
+	token, err := s.dao.GetToken(filter.Token)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetToken: %s", err.Error())
+	}
+	dItems, err := s.dao.GetTokenDailyStatsRange(filter)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetTokenDailyStatsRange: %s", err.Error())
+	}
+	items = make([]smodels.RangeItem, len(dItems))
+	for i, it := range dItems {
+		var value decimal.Decimal
+		switch filter.Key {
+		case dailystats.TokenHoldersKey:
+			value = decimal.New(int64(it.Holders), 0)
+		case dailystats.TokenTransfersKey:
+			value = decimal.New(int64(it.Transfers), 0)
+		case dailystats.TokenVolumeKey:
+			value = it.Volume.Shift(-int32(token.Decimals))
+		}
+		items[i] = smodels.RangeItem{
+			Value: value,
+			Time:  smodels.NewTime(it.CreatedAt),
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+)
+
+var tokenSupplyOperations = []string{dmodels.ESDTLocalMintOperation, dmodels.ESDTLocalBurnOperation}
+
+func (s *ServiceFacade) GetTokenSupplyRange(filter filters.TokenDailyStats) (items []smodels.RangeItem, err error) {
+	dItems, err := s.dao.GetTokenSupplyRange(filter)
+	if err != nil {
+		return items, fmt.Errorf("dao.GetTokenSupplyRange: %s", err.Error())
+	}
+	items = make([]smodels.RangeItem, len(dItems))
+	for i, it := range dItems {
+		items[i] = smodels.RangeItem{
+			Value: it.Supply,
+			Time:  smodels.NewTime(it.CreatedAt),
+		}
+	}
+	return items, nil
+}
+
+// GetTokenMints returns mint and burn operations of the token, the type filter may narrow them down to one of them
+func (s *ServiceFacade) GetTokenMints(filter filters.Operations) (items smodels.Pagination, err error) {
+	var types []string
+	for _, t := range filter.Type {
+		for _, op := range tokenSupplyOperations {
+			if t == op {
+				types = append(types, t)
+			}
+		}
+	}
+	if len(types) == 0 {
+		types = tokenSupplyOperations
+	}
+	filter.Type = types
+	items, err = s.GetOperations(filter)
+	if err != nil {
+		return items, fmt.Errorf("GetOperations: %s", err.Error())
+	}
+	return items, nil
+}
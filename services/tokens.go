@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/ElrondNetwork/elastic-indexer-go/data"
+	"github.com/everstake/elrond-monitor-backend/dao/derrors"
 	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
 	"github.com/everstake/elrond-monitor-backend/dao/filters"
 	"github.com/everstake/elrond-monitor-backend/dao/postgres"
@@ -12,38 +13,32 @@ import (
 	"github.com/everstake/elrond-monitor-backend/services/node"
 	"github.com/everstake/elrond-monitor-backend/smodels"
 	"github.com/shopspring/decimal"
+	"net/http"
 	"strings"
 	"time"
 )
 
-func (s *ServiceFacade) UpdateTokens() {
-	tn := time.Now()
-	tokenIdents, err := s.node.GetESDTs()
+// syncToken refreshes the properties, roles and supply of the token or NFT collection
+func (s *ServiceFacade) syncToken(tokenIdent string) error {
+	props, err := s.node.GetESDTProperties(tokenIdent)
 	if err != nil {
-		log.Error("UpdateTokens: node.GetESDTs: %s", err.Error())
-		return
+		return fmt.Errorf("node.GetESDTProperties: %s", err.Error())
 	}
-	for _, tokenIdent := range tokenIdents {
-		props, err := s.node.GetESDTProperties(tokenIdent)
+	switch props.Type {
+	case dmodels.FungibleESDT, dmodels.MetaESDT:
+		err = s.updateFungibleToken(tokenIdent, props)
 		if err != nil {
-			log.Error("UpdateTokens: node.GetESDTProperties: %s", err.Error())
+			return fmt.Errorf("updateFungibleToken: %s", err.Error())
 		}
-		switch props.Type {
-		case dmodels.FungibleESDT, dmodels.MetaESDT:
-			err = s.updateFungibleToken(tokenIdent, props)
-			if err != nil {
-				log.Error("UpdateTokens: updateFungibleToken: %s", err.Error())
-			}
-		case dmodels.NonFungibleESDT, dmodels.SemiFungibleESDT:
-			err = s.updateNonFungibleToken(tokenIdent, props)
-			if err != nil {
-				log.Error("UpdateTokens: updateNonFungibleToken: %s", err.Error())
-			}
-		default:
-			log.Warn("UpdateTokens: unknown type: %s", props.Type)
+	case dmodels.NonFungibleESDT, dmodels.SemiFungibleESDT:
+		err = s.updateNonFungibleToken(tokenIdent, props)
+		if err != nil {
+			return fmt.Errorf("updateNonFungibleToken: %s", err.Error())
 		}
+	default:
+		log.Warn("syncToken: unknown type: %s", props.Type)
 	}
-	log.Debug("UpdateTokens: complete. duration: %s", time.Now().Sub(tn))
+	return nil
 }
 
 func (s *ServiceFacade) updateFungibleToken(tokenIdent string, props node.ESDTProperties) error {
@@ -89,14 +84,36 @@ func (s *ServiceFacade) updateFungibleToken(tokenIdent string, props node.ESDTPr
 			return fmt.Errorf("dao.UpdateToken: %s", err.Error())
 		}
 	}
+	err = s.dao.UpdateTokenSupply(dmodels.TokenSupply{
+		Token:     tokenIdent,
+		Supply:    supply,
+		CreatedAt: truncateTime(time.Now(), filters.DayInterval),
+	})
+	if err != nil {
+		return fmt.Errorf("dao.UpdateTokenSupply: %s", err.Error())
+	}
 	return nil
 }
 
 func (s *ServiceFacade) updateNonFungibleToken(tokenIdent string, props node.ESDTProperties) error {
 	propsJSON, _ := json.Marshal(props)
+	existing, err := s.dao.GetNFTCollection(tokenIdent)
+	if err != nil && err.Error() != postgres.NoRowsError {
+		return fmt.Errorf("dao.GetNFTCollection: %s", err.Error())
+	}
+	createdAt := existing.CreatedAt
 	tokenInfo, err := s.dao.GetTokenInfo(tokenIdent)
-	if err != nil {
+	switch {
+	case err == nil:
+		createdAt = time.Unix(int64(tokenInfo.Timestamp), 0)
+	case err != derrors.NotFound:
 		return fmt.Errorf("dao.GetTokenInfo: %s", err.Error())
+	case createdAt.IsZero():
+		// the data backend doesn't know the collection, so the time of the issue tx seen by the parser is used
+		createdAt, err = s.tokenIssuedAt(tokenIdent)
+		if err != nil {
+			return fmt.Errorf("tokenIssuedAt: %s", err.Error())
+		}
 	}
 	name := tokenInfo.Name
 	if name == "" {
@@ -109,40 +126,73 @@ func (s *ServiceFacade) updateNonFungibleToken(tokenIdent string, props node.ESD
 		Owner:      props.Owner,
 		Type:       props.Type,
 		Properties: propsJSON,
-		CreatedAt:  time.Unix(int64(tokenInfo.Timestamp), 0),
+		CreatedAt:  createdAt,
 	}
-	_, err = s.dao.GetNFTCollection(tokenIdent)
-	if err != nil {
-		if err.Error() == postgres.NoRowsError {
-			err = s.dao.CreateNFTCollection(collection)
-			if err != nil {
-				return fmt.Errorf("dao.CreateToken: %s", err.Error())
-			}
+	if existing.Identity == "" {
+		err = s.dao.CreateNFTCollection(collection)
+		if err != nil {
+			return fmt.Errorf("dao.CreateNFTCollection: %s", err.Error())
 		}
 	} else {
 		err = s.dao.UpdateNFTCollection(collection)
 		if err != nil {
-			return fmt.Errorf("dao.UpdateToken: %s", err.Error())
+			return fmt.Errorf("dao.UpdateNFTCollection: %s", err.Error())
 		}
 	}
 	return nil
 }
 
+// tokenIssuedAt returns the time of the issue tx seen by the parser,
+// the zero unix time is returned for the tokens issued before the parsing
+func (s *ServiceFacade) tokenIssuedAt(tokenIdent string) (issuedAt time.Time, err error) {
+	sync, err := s.dao.GetTokenSync(tokenIdent)
+	if err != nil && err.Error() != postgres.NoRowsError {
+		return issuedAt, fmt.Errorf("dao.GetTokenSync: %s", err.Error())
+	}
+	if sync.CreatedAt == nil {
+		return time.Unix(0, 0), nil
+	}
+	return *sync.CreatedAt, nil
+}
+
 func (s *ServiceFacade) GetNFT(id string) (sNFT smodels.NFT, err error) {
 	nft, err := s.dao.GetTokenInfo(id)
 	if err != nil {
+		if err == derrors.NotSupported {
+			return sNFT, smodels.Error{
+				Err:      err.Error(),
+				Msg:      "nft is not supported by the data backend",
+				HttpCode: http.StatusNotImplemented,
+			}
+		}
 		return sNFT, fmt.Errorf("dao.GetTokenInfo: %s", err.Error())
 	}
 	if nft.Data == nil {
 		return sNFT, fmt.Errorf("empty data in nft token %s", nft.Identifier)
 	}
-	return toNFTSModel(nft), nil
+	sNFT = toNFTSModel(nft)
+	sNFT.Metadata, err = s.getNFTMetadata(nft.Identifier)
+	if err != nil {
+		return sNFT, fmt.Errorf("getNFTMetadata: %s", err.Error())
+	}
+	sNFT.Owners, err = s.getNFTOwners(nft.Identifier)
+	if err != nil {
+		return sNFT, fmt.Errorf("getNFTOwners: %s", err.Error())
+	}
+	return sNFT, nil
 }
 
 func (s *ServiceFacade) GetNFTs(filter filters.NFTTokens) (pagination smodels.Pagination, err error) {
 	tokens, err := s.dao.GetNFTTokens(filter)
 	if err != nil {
-		return pagination, fmt.Errorf("dao.GetCollectionNFTs: %s", err.Error())
+		if err == derrors.NotSupported {
+			return pagination, smodels.Error{
+				Err:      err.Error(),
+				Msg:      "nfts list is not supported by the data backend",
+				HttpCode: http.StatusNotImplemented,
+			}
+		}
+		return pagination, fmt.Errorf("dao.GetNFTTokens: %s", err.Error())
 	}
 	nfts := make([]smodels.NFT, len(tokens))
 	for i, t := range tokens {
@@ -219,6 +269,7 @@ func toNFTCollectionSModel(collection dmodels.NFTCollection) smodels.NFTCollecti
 		Type:       collection.Type,
 		Properties: collection.Properties,
 		CreatedAt:  smodels.NewTime(collection.CreatedAt),
+		Stats:      toNFTCollectionStatsSModel(collection.NFTCollectionStats),
 	}
 }
 
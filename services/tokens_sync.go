@@ -0,0 +1,108 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	tokensSyncWorkers    = 5
+	tokensResyncInterval = time.Hour * 24
+)
+
+// UpdateTokens syncs new tokens, the ones changed in the chain since the last sync and the ones not synced for a day
+func (s *ServiceFacade) UpdateTokens() {
+	if !atomic.CompareAndSwapInt32(&s.tokensSyncRunning, 0, 1) {
+		log.Warn("UpdateTokens: previous sync is still running")
+		return
+	}
+	defer atomic.StoreInt32(&s.tokensSyncRunning, 0)
+	tn := time.Now()
+	identifiers, err := s.getTokensToSync(tn)
+	if err != nil {
+		log.Error("UpdateTokens: getTokensToSync: %s", err.Error())
+		return
+	}
+	failed := s.syncTokens(identifiers)
+	metrics := smodels.TokensSync{
+		StartedAt: smodels.NewTime(tn),
+		Duration:  time.Now().Sub(tn).Seconds(),
+		Total:     uint64(len(identifiers)),
+		Failed:    failed,
+	}
+	if len(identifiers) > 0 {
+		metrics.AvgTokenDuration = metrics.Duration / float64(len(identifiers))
+	}
+	err = s.setCache(dmodels.TokensSyncStorageKey, metrics)
+	if err != nil {
+		log.Error("UpdateTokens: setCache: %s", err.Error())
+	}
+	log.Debug("UpdateTokens: complete. tokens: %d, failed: %d, duration: %s", len(identifiers), failed, time.Now().Sub(tn))
+}
+
+func (s *ServiceFacade) getTokensToSync(now time.Time) (identifiers []string, err error) {
+	tokenIdents, err := s.node.GetESDTs()
+	if err != nil {
+		return nil, fmt.Errorf("node.GetESDTs: %s", err.Error())
+	}
+	syncs, err := s.dao.GetTokensSync()
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetTokensSync: %s", err.Error())
+	}
+	syncsMap := make(map[string]dmodels.TokenSync, len(syncs))
+	for _, item := range syncs {
+		syncsMap[item.Identifier] = item
+	}
+	for _, ident := range tokenIdents {
+		item, ok := syncsMap[ident]
+		switch {
+		case !ok, item.ChangedAt.After(item.SyncedAt), now.Sub(item.SyncedAt) > tokensResyncInterval:
+			identifiers = append(identifiers, ident)
+		}
+	}
+	return identifiers, nil
+}
+
+// syncTokens refreshes the tokens with the pool of workers, returns the number of failed tokens
+func (s *ServiceFacade) syncTokens(identifiers []string) (failed uint64) {
+	ch := make(chan string)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < tokensSyncWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ident := range ch {
+				syncedAt := time.Now()
+				err := s.syncToken(ident)
+				if err != nil {
+					atomic.AddUint64(&failed, 1)
+					log.Error("UpdateTokens: syncToken(%s): %s", ident, err.Error())
+					continue
+				}
+				err = s.dao.UpdateTokenSynced(ident, syncedAt)
+				if err != nil {
+					log.Error("UpdateTokens: dao.UpdateTokenSynced: %s", err.Error())
+				}
+			}
+		}()
+	}
+	for _, ident := range identifiers {
+		ch <- ident
+	}
+	close(ch)
+	wg.Wait()
+	return failed
+}
+
+func (s *ServiceFacade) GetTokensSync() (metrics smodels.TokensSync, err error) {
+	err = s.getCache(dmodels.TokensSyncStorageKey, &metrics)
+	if err != nil {
+		return metrics, fmt.Errorf("getCache: %s", err.Error())
+	}
+	return metrics, nil
+}
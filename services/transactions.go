@@ -77,6 +77,10 @@ func (s *ServiceFacade) GetTransaction(hash string) (tx smodels.Tx, err error) {
 			Message: r.ReturnMessage,
 		}
 	}
+	decoded := s.decodeData(dTx.Receiver, string(dTx.Data))
+	for i := range results {
+		results[i].Decoded = s.decodeSCResult(results[i], decoded)
+	}
 	val, _ := decimal.NewFromString(dTx.Value)
 	fee, _ := decimal.NewFromString(dTx.Fee)
 	return smodels.Tx{
@@ -94,6 +98,7 @@ func (s *ServiceFacade) GetTransaction(hash string) (tx smodels.Tx, err error) {
 		ScResults:     results,
 		Signature:     dTx.Signature,
 		Data:          string(dTx.Data),
+		Decoded:       decoded,
 		Timestamp:     smodels.NewTime(time.Unix(int64(dTx.Timestamp), 0)),
 	}, nil
 }
@@ -163,6 +168,8 @@ func (s *ServiceFacade) GetOperations(filter filters.Operations) (items smodels.
 			Tokens:         op.Tokens,
 			ESDTValues:     op.ESDTValues,
 			TokensDetails:  tokensDetails,
+			Data:           string(op.Data),
+			Decoded:        s.decodeData(op.Receiver, string(op.Data)),
 		}
 	}
 	return smodels.Pagination{
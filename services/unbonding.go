@@ -0,0 +1,153 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/shopspring/decimal"
+	"sort"
+)
+
+// GetAccountUnbonding returns the undelegated and unstaked funds of the account which haven't been withdrawn yet
+func (s *ServiceFacade) GetAccountUnbonding(address string) (unbonding smodels.AccountUnbonding, err error) {
+	status, err := s.node.GetNetworkStatus(node.MetaChainShardIndex)
+	if err != nil {
+		return unbonding, fmt.Errorf("node.GetNetworkStatus: %s", err.Error())
+	}
+	periods, err := s.getUnbondPeriods()
+	if err != nil {
+		return unbonding, fmt.Errorf("getUnbondPeriods: %s", err.Error())
+	}
+	events, err := s.dao.GetUnbondingEvents(filters.UnbondingEvents{Delegator: address})
+	if err != nil {
+		return unbonding, fmt.Errorf("dao.GetUnbondingEvents: %s", err.Error())
+	}
+	unbonding = smodels.AccountUnbonding{
+		Unbonding:    decimal.Zero,
+		Withdrawable: decimal.Zero,
+		Items:        []smodels.Unbonding{},
+	}
+	for _, item := range pendingUnbondings(events, periods.get) {
+		item.Withdrawable = item.WithdrawableEpoch <= status.ErdEpochNumber
+		if item.Withdrawable {
+			unbonding.Withdrawable = unbonding.Withdrawable.Add(item.Amount)
+		} else {
+			unbonding.Unbonding = unbonding.Unbonding.Add(item.Amount)
+		}
+		unbonding.Items = append(unbonding.Items, item)
+	}
+	return unbonding, nil
+}
+
+// GetUnbondingStats returns the total amount which is currently in the unbonding period and the schedule of its release
+func (s *ServiceFacade) GetUnbondingStats() (stats smodels.UnbondingStats, err error) {
+	status, err := s.node.GetNetworkStatus(node.MetaChainShardIndex)
+	if err != nil {
+		return stats, fmt.Errorf("node.GetNetworkStatus: %s", err.Error())
+	}
+	periods, err := s.getUnbondPeriods()
+	if err != nil {
+		return stats, fmt.Errorf("getUnbondPeriods: %s", err.Error())
+	}
+	var fromEpoch uint64
+	if status.ErdEpochNumber > periods.max {
+		fromEpoch = status.ErdEpochNumber - periods.max
+	}
+	amounts, err := s.dao.GetUnbondingAmounts(fromEpoch)
+	if err != nil {
+		return stats, fmt.Errorf("dao.GetUnbondingAmounts: %s", err.Error())
+	}
+	releases := make(map[uint64]decimal.Decimal)
+	for _, a := range amounts {
+		// the funds can't be withdrawn before the end of the period, so they are unbonding regardless of the withdrawals
+		epoch := a.Epoch + periods.get(a.Validator)
+		if epoch <= status.ErdEpochNumber {
+			continue
+		}
+		releases[epoch] = releases[epoch].Add(a.Amount)
+	}
+	stats = smodels.UnbondingStats{
+		Epoch:    status.ErdEpochNumber,
+		Total:    decimal.Zero,
+		Releases: []smodels.UnbondingRelease{},
+	}
+	for epoch, amount := range releases {
+		stats.Total = stats.Total.Add(amount)
+		stats.Releases = append(stats.Releases, smodels.UnbondingRelease{Epoch: epoch, Amount: amount})
+	}
+	sort.Slice(stats.Releases, func(i, j int) bool {
+		return stats.Releases[i].Epoch < stats.Releases[j].Epoch
+	})
+	return stats, nil
+}
+
+type unbondPeriods struct {
+	providers map[string]uint64
+	def       uint64
+	max       uint64
+}
+
+func (p unbondPeriods) get(validator string) uint64 {
+	if period, ok := p.providers[validator]; ok {
+		return period
+	}
+	return p.def
+}
+
+// getUnbondPeriods returns the unbond periods of the providers, the configured period is used for the rest of validators
+func (s *ServiceFacade) getUnbondPeriods() (periods unbondPeriods, err error) {
+	var providers []smodels.StakingProvider
+	err = s.getCache(dmodels.StakingProvidersStorageKey, &providers)
+	if err != nil {
+		return periods, fmt.Errorf("getCache: %s", err.Error())
+	}
+	periods = unbondPeriods{
+		providers: make(map[string]uint64),
+		def:       s.cfg.Staking.UnBondPeriod,
+		max:       s.cfg.Staking.UnBondPeriod,
+	}
+	for _, p := range providers {
+		if p.UnBondPeriod == 0 {
+			continue
+		}
+		periods.providers[p.Provider] = p.UnBondPeriod
+		if p.UnBondPeriod > periods.max {
+			periods.max = p.UnBondPeriod
+		}
+	}
+	return periods, nil
+}
+
+// pendingUnbondings matches the undelegations and unstakes with the withdrawals, the withdrawal (or unbond) takes
+// all the funds of the validator which have passed the unbond period by the epoch of the withdrawal
+func pendingUnbondings(events []dmodels.StakeEvent, period func(validator string) uint64) (items []smodels.Unbonding) {
+	for i, e := range events {
+		if e.Type != dmodels.UnDelegateStakeEventType && e.Type != dmodels.UnStakeEventType {
+			continue
+		}
+		withdrawableEpoch := e.Epoch + period(e.Validator)
+		withdrawn := false
+		for _, w := range events[i+1:] {
+			if (w.Type == dmodels.WithdrawEventType || w.Type == dmodels.UnBondEventType) &&
+				w.Validator == e.Validator && w.Epoch >= withdrawableEpoch {
+				withdrawn = true
+				break
+			}
+		}
+		if withdrawn {
+			continue
+		}
+		items = append(items, smodels.Unbonding{
+			TxHash:            e.TxHash,
+			Type:              e.Type,
+			Provider:          e.Validator,
+			Amount:            e.Amount.Abs(),
+			Epoch:             e.Epoch,
+			WithdrawableEpoch: withdrawableEpoch,
+			CreatedAt:         smodels.NewTime(e.CreatedAt),
+		})
+	}
+	return items
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
+	"github.com/everstake/elrond-monitor-backend/dao/filters"
+	"github.com/everstake/elrond-monitor-backend/log"
+	"github.com/everstake/elrond-monitor-backend/services/node"
+	"github.com/everstake/elrond-monitor-backend/smodels"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// shownValidatorEpochs is the number of the last epochs returned with the node and the validator
+const shownValidatorEpochs = 10
+
+func (s *ServiceFacade) UpdateValidatorEpochs() {
+	err := s.updateValidatorEpochs()
+	if err != nil {
+		log.Error("updateValidatorEpochs: %s", err.Error())
+	}
+}
+
+// updateValidatorEpochs saves the proposed and missed blocks and the signatures of every BLS key in the current epoch.
+// The node resets the counters at the start of the epoch, so the last snapshot within the epoch is kept,
+// and the previous epoch is completed by the totals which grow only at the reset.
+// The epoch is read before and after the statistics, the snapshot taken at the epoch change is skipped
+// to not save the reset counters under the previous epoch
+func (s *ServiceFacade) updateValidatorEpochs() error {
+	status, err := s.node.GetNetworkStatus(node.MetaChainShardIndex)
+	if err != nil {
+		return fmt.Errorf("node.GetNetworkStatus: %s", err.Error())
+	}
+	statistics, err := s.node.GetValidatorStatistics()
+	if err != nil {
+		return fmt.Errorf("node.GetValidatorStatistics: %s", err.Error())
+	}
+	statusAfter, err := s.node.GetNetworkStatus(node.MetaChainShardIndex)
+	if err != nil {
+		return fmt.Errorf("node.GetNetworkStatus: %s", err.Error())
+	}
+	if statusAfter.ErdEpochNumber != status.ErdEpochNumber {
+		log.Warn("updateValidatorEpochs: epoch changed from %d to %d, snapshot is skipped", status.ErdEpochNumber, statusAfter.ErdEpochNumber)
+		return nil
+	}
+	now := time.Now()
+	items := make([]dmodels.ValidatorEpoch, 0, len(statistics))
+	totals := make([]dmodels.ValidatorEpoch, 0, len(statistics))
+	for key, stat := range statistics {
+		item := dmodels.ValidatorEpoch{
+			Key:                   key,
+			Epoch:                 status.ErdEpochNumber,
+			Shard:                 stat.ShardID,
+			Proposed:              uint64(stat.NumLeaderSuccess),
+			Missed:                uint64(stat.NumLeaderFailure),
+			Signed:                uint64(stat.NumValidatorSuccess),
+			MissedSignatures:      uint64(stat.NumValidatorFailure),
+			TotalProposed:         uint64(stat.TotalNumLeaderSuccess),
+			TotalMissed:           uint64(stat.TotalNumLeaderFailure),
+			TotalSigned:           uint64(stat.TotalNumValidatorSuccess),
+			TotalMissedSignatures: uint64(stat.TotalNumValidatorFailure),
+			UpdatedAt:             now,
+		}
+		totals = append(totals, item)
+		if item.Proposed+item.Missed+item.Signed+item.MissedSignatures == 0 {
+			continue
+		}
+		items = append(items, item)
+	}
+	err = s.dao.UpdateValidatorEpochs(items)
+	if err != nil {
+		return fmt.Errorf("dao.UpdateValidatorEpochs: %s", err.Error())
+	}
+	if status.ErdEpochNumber == 0 {
+		return nil
+	}
+	err = s.dao.CompleteValidatorEpochs(totals)
+	if err != nil {
+		return fmt.Errorf("dao.CompleteValidatorEpochs: %s", err.Error())
+	}
+	return nil
+}
+
+func (s *ServiceFacade) getValidatorEpochs(keys []string) (items []smodels.ValidatorEpoch, err error) {
+	dItems, err := s.dao.GetValidatorEpochs(filters.ValidatorEpochs{
+		Keys:   keys,
+		Epochs: shownValidatorEpochs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dao.GetValidatorEpochs: %s", err.Error())
+	}
+	items = make([]smodels.ValidatorEpoch, len(dItems))
+	for i, it := range dItems {
+		items[i] = smodels.ValidatorEpoch{
+			Epoch:            it.Epoch,
+			Shard:            it.Shard,
+			Proposed:         it.Proposed,
+			Missed:           it.Missed,
+			Signed:           it.Signed,
+			MissedSignatures: it.MissedSignatures,
+			ProposalRate:     successRate(it.Proposed, it.Missed),
+			SignatureRate:    successRate(it.Signed, it.MissedSignatures),
+		}
+	}
+	return items, nil
+}
+
+// successRate returns the share of the successes in percents
+func successRate(success uint64, failure uint64) decimal.Decimal {
+	if success+failure == 0 {
+		return decimal.Zero
+	}
+	return decimal.New(int64(success), 2).Div(decimal.New(int64(success+failure), 0)).Round(2)
+}
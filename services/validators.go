@@ -1,7 +1,6 @@
 package services
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/everstake/elrond-monitor-backend/dao/dmodels"
@@ -9,9 +8,8 @@ import (
 	"github.com/everstake/elrond-monitor-backend/log"
 	"github.com/everstake/elrond-monitor-backend/smodels"
 	"github.com/shopspring/decimal"
-	"net/http"
 	"sort"
-	"time"
+	"sync/atomic"
 )
 
 func (s *ServiceFacade) GetValidators(filter filters.Validators) (pagination smodels.Pagination, err error) {
@@ -41,6 +39,21 @@ func (s *ServiceFacade) GetValidator(identity string) (validator smodels.Identit
 	}
 	for _, n := range validators {
 		if n.Identity == identity {
+			var nodes []smodels.Node
+			err = s.getCache(dmodels.NodesStorageKey, &nodes)
+			if err != nil {
+				return validator, fmt.Errorf("getCache(nodes): %s", err.Error())
+			}
+			var keys []string
+			for _, item := range nodes {
+				if item.Identity == identity {
+					keys = append(keys, item.PublicKey)
+				}
+			}
+			n.Epochs, err = s.getValidatorEpochs(keys)
+			if err != nil {
+				return validator, fmt.Errorf("getValidatorEpochs: %s", err.Error())
+			}
 			return n, nil
 		}
 	}
@@ -52,7 +65,13 @@ func (s *ServiceFacade) GetValidator(identity string) (validator smodels.Identit
 	}
 }
 
+// UpdateValidators is run by the scheduler and after the changes of the identity overrides, the concurrent runs are skipped
 func (s *ServiceFacade) UpdateValidators() {
+	if !atomic.CompareAndSwapInt32(&s.validatorsUpdateRunning, 0, 1) {
+		log.Warn("UpdateValidators: previous update is still running")
+		return
+	}
+	defer atomic.StoreInt32(&s.validatorsUpdateRunning, 0)
 	err := s.updateValidators()
 	if err != nil {
 		log.Error("updateValidators: %s", err.Error())
@@ -111,9 +130,9 @@ func (s *ServiceFacade) updateValidators() error {
 		}
 		locked := stake.Add(topUp)
 		stakePercent, _ := locked.Mul(decimal.New(100, 0)).Div(totalLocked).Float64()
-		var kb smodels.IdentityKeybase
+		var profile dmodels.IdentityProfile
 		if len(key) < 192 && len(key) != 0 {
-			kb, err = s.getIdentityProfile(key)
+			profile, err = s.getIdentityProfile(key)
 			if err != nil {
 				log.Warn("updateValidators: getIdentityProfile(%s): %s", key, err.Error())
 			}
@@ -123,11 +142,12 @@ func (s *ServiceFacade) updateValidators() error {
 			avgUptime = totalUptime / float64(count)
 		}
 		identities = append(identities, smodels.Identity{
-			Avatar:       kb.Them.Pictures.Primary.URL,
-			Description:  kb.Them.Profile.Bio,
+			Avatar:       profile.Avatar,
+			Description:  profile.Description,
+			Website:      profile.Website,
 			Identity:     key,
 			Locked:       locked,
-			Name:         kb.Them.Profile.FullName,
+			Name:         profile.Name,
 			Score:        uint64(score),
 			Stake:        stake,
 			StakePercent: stakePercent,
@@ -149,20 +169,3 @@ func (s *ServiceFacade) updateValidators() error {
 	}
 	return nil
 }
-
-func (s *ServiceFacade) getIdentityProfile(identity string) (data smodels.IdentityKeybase, err error) {
-	client := http.Client{Timeout: time.Second * 30}
-	resp, err := client.Get(fmt.Sprintf("https://keybase.io/_/api/1.0/user/lookup.json?username=%s", identity))
-	if err != nil {
-		return data, fmt.Errorf("client.Get: %s", err.Error())
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return data, fmt.Errorf("status code: %d", resp.StatusCode)
-	}
-	err = json.NewDecoder(resp.Body).Decode(&data)
-	if err != nil {
-		return data, fmt.Errorf("json.Decode: %s", err.Error())
-	}
-	return data, nil
-}
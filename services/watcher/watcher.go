@@ -18,6 +18,7 @@ type Watcher struct {
 	dao           dao.DAO
 	lastBlockTime int64
 	lastTxTime    int64
+	lastTPSTime   int64
 	stop          chan struct{}
 	ws            ws.WS
 }
@@ -58,6 +59,8 @@ func (w *Watcher) Run() (err error) {
 		case <-w.stop:
 			return nil
 		case <-time.After(interval):
+			w.broadcastTPS()
+
 			// blocks
 			blocks, err = w.dao.GetBlocks(filters.Blocks{
 				Pagination: filters.Pagination{Limit: 10},
@@ -144,6 +147,31 @@ func (w *Watcher) Run() (err error) {
 	}
 }
 
+// broadcastTPS sends TPS of the last completed minute once the parser moves to the next one
+func (w *Watcher) broadcastTPS() {
+	items, err := w.dao.GetLatestThroughput(2)
+	if err != nil {
+		log.Warn("Watcher: dao.GetLatestThroughput: %s", err.Error())
+		return
+	}
+	if len(items) < 2 {
+		return
+	}
+	last := items[1]
+	t := last.CreatedAt.Unix()
+	if t <= w.lastTPSTime {
+		return
+	}
+	w.ws.Broadcast(ws.Broadcast{
+		Channel: ws.TPSChannel,
+		Data: smodels.RangeItem{
+			Value: decimal.New(int64(last.Txs), 0).Div(decimal.New(60, 0)).Round(2),
+			Time:  smodels.NewTime(last.CreatedAt),
+		},
+	})
+	w.lastTPSTime = t
+}
+
 func (w *Watcher) Stop() error {
 	w.stop <- struct{}{}
 	return nil
@@ -12,6 +12,7 @@ type (
 		RewardsClaimed   decimal.Decimal          `json:"rewards_claimed"`
 		ClaimableRewards decimal.Decimal          `json:"claimable_rewards"`
 		StakingProviders []AccountStakingProvider `json:"staking_providers"`
+		Stake            StakeBreakdown           `json:"stake"`
 	}
 	AccountStakingProvider struct {
 		Provider string          `json:"provider"`
@@ -23,3 +24,17 @@ type (
 		Token   TokenMetaInfo   `json:"token"`
 	}
 )
+
+type (
+	// StakeBreakdown is the stake by the sources: the direct stake in the validators,
+	// the legacy delegation contract and the staking providers
+	StakeBreakdown struct {
+		Direct    decimal.Decimal `json:"direct"`
+		Legacy    decimal.Decimal `json:"legacy"`
+		Providers decimal.Decimal `json:"providers"`
+	}
+	StakeBreakdownItem struct {
+		Day Time `json:"day"`
+		StakeBreakdown
+	}
+)
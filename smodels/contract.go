@@ -0,0 +1,22 @@
+package smodels
+
+type (
+	Contract struct {
+		Address       string `json:"address"`
+		Deployer      string `json:"deployer"`
+		Owner         string `json:"owner"`
+		CodeHash      string `json:"code_hash"`
+		DeployTxHash  string `json:"deploy_tx_hash"`
+		UpgradeTxHash string `json:"upgrade_tx_hash"`
+		Upgrades      uint64 `json:"upgrades"`
+		Calls         uint64 `json:"calls"`
+		DeployedAt    Time   `json:"deployed_at"`
+		UpdatedAt     Time   `json:"updated_at"`
+		LastCallAt    Time   `json:"last_call_at"`
+	}
+	ContractCall struct {
+		Function   string `json:"function"`
+		Calls      uint64 `json:"calls"`
+		LastCallAt Time   `json:"last_call_at"`
+	}
+)
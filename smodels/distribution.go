@@ -0,0 +1,26 @@
+package smodels
+
+import "github.com/shopspring/decimal"
+
+type (
+	Distribution struct {
+		Holders      uint64               `json:"holders"`
+		TotalBalance decimal.Decimal      `json:"total_balance"`
+		Gini         decimal.Decimal      `json:"gini"`
+		Buckets      []DistributionBucket `json:"buckets"`
+		TopShares    []TopHoldersShare    `json:"top_shares"`
+		UpdatedAt    Time                 `json:"updated_at"`
+	}
+	// DistributionBucket contains holders with balance in range [From, To), zero To means no upper bound
+	DistributionBucket struct {
+		From   decimal.Decimal `json:"from"`
+		To     decimal.Decimal `json:"to"`
+		Count  uint64          `json:"count"`
+		Amount decimal.Decimal `json:"amount"`
+	}
+	TopHoldersShare struct {
+		Top    uint64          `json:"top"`
+		Amount decimal.Decimal `json:"amount"`
+		Share  decimal.Decimal `json:"share"`
+	}
+)
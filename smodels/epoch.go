@@ -1,5 +1,7 @@
 package smodels
 
+import "github.com/shopspring/decimal"
+
 type Epoch struct {
 	CurrentRound   uint64  `json:"current_round"`
 	EpochNumber    uint64  `json:"epoch_number"`
@@ -9,3 +11,19 @@ type Epoch struct {
 	Left           uint64  `json:"left"`
 	Start          Time    `json:"start"`
 }
+
+// EpochSummary is the activity of the past (or current) epoch, the network state is taken at the start of the epoch
+type EpochSummary struct {
+	Epoch      uint64          `json:"epoch"`
+	StartNonce uint64          `json:"start_nonce"`
+	EndNonce   uint64          `json:"end_nonce"`
+	StartTime  Time            `json:"start_time"`
+	EndTime    Time            `json:"end_time"`
+	Txs        uint64          `json:"txs"`
+	Fees       decimal.Decimal `json:"fees"`
+	Rewards    decimal.Decimal `json:"rewards"`
+	Validators uint64          `json:"validators"`
+	Stake      decimal.Decimal `json:"stake"`
+	TopUp      decimal.Decimal `json:"top_up"`
+	Shards     uint64          `json:"shards"`
+}
@@ -0,0 +1,8 @@
+package smodels
+
+import "github.com/shopspring/decimal"
+
+type ContractFees struct {
+	Contract string          `json:"contract"`
+	Fees     decimal.Decimal `json:"fees"`
+}
@@ -20,14 +20,55 @@ const (
 type Node struct {
 	node.HeartbeatStatus
 	node.ValidatorStatistic
-	Type     string          `json:"type"`
-	Status   string          `json:"status"`
-	UpTime   float64         `json:"upTime"`
-	DownTime float64         `json:"downTime"`
-	Owner    string          `json:"owner"`
-	Provider string          `json:"provider"`
-	Stake    decimal.Decimal `json:"stake"`
-	TopUp    decimal.Decimal `json:"topUp"`
-	Locked   decimal.Decimal `json:"locked"`
-	Position int64           `json:"position"`
+	Type     string           `json:"type"`
+	Status   string           `json:"status"`
+	UpTime   float64          `json:"upTime"`
+	DownTime float64          `json:"downTime"`
+	Owner    string           `json:"owner"`
+	Provider string           `json:"provider"`
+	Stake    decimal.Decimal  `json:"stake"`
+	TopUp    decimal.Decimal  `json:"topUp"`
+	Locked   decimal.Decimal  `json:"locked"`
+	Position int64            `json:"position"`
+	Epochs   []ValidatorEpoch `json:"epochs,omitempty"`
+	Queue    *NodeQueue       `json:"queue,omitempty"`
+}
+
+// NodeQueue is the estimated activation of the queued node, the activation isn't estimated until the queue moves
+type NodeQueue struct {
+	Position        int64           `json:"position"`
+	ActivationEpoch uint64          `json:"activation_epoch,omitempty"`
+	ActivationTime  *Time           `json:"activation_time,omitempty"`
+	Positions       []QueuePosition `json:"positions,omitempty"`
+}
+
+type QueuePosition struct {
+	Epoch    uint64 `json:"epoch"`
+	Position int64  `json:"position"`
+}
+
+type QueueNode struct {
+	PublicKey string `json:"public_key"`
+	Owner     string `json:"owner"`
+	Provider  string `json:"provider"`
+	NodeQueue
+}
+
+type Queue struct {
+	Epoch uint64 `json:"epoch"`
+	// Speed is the average number of the nodes leaving the queue per epoch
+	Speed decimal.Decimal `json:"speed"`
+	Nodes []QueueNode     `json:"nodes"`
+}
+
+// ValidatorEpoch is the consensus activity within the epoch, the rates are in percents
+type ValidatorEpoch struct {
+	Epoch            uint64          `json:"epoch"`
+	Shard            uint64          `json:"shard"`
+	Proposed         uint64          `json:"proposed"`
+	Missed           uint64          `json:"missed"`
+	Signed           uint64          `json:"signed"`
+	MissedSignatures uint64          `json:"missed_signatures"`
+	ProposalRate     decimal.Decimal `json:"proposal_rate"`
+	SignatureRate    decimal.Decimal `json:"signature_rate"`
 }
@@ -2,11 +2,20 @@ package smodels
 
 import "github.com/shopspring/decimal"
 
+// RankingBucket is the delegators with the stake from the boundary to the next one, To is null for the last bucket
+type RankingBucket struct {
+	From   decimal.Decimal  `json:"from"`
+	To     *decimal.Decimal `json:"to"`
+	Amount decimal.Decimal  `json:"amount"`
+	Count  uint64           `json:"count"`
+}
+
 type RankingRange struct {
 	Amount decimal.Decimal `json:"amount"`
 	Count  uint64          `json:"count"`
 }
 
+// Ranking is the ranking of the provider with the default buckets
 type Ranking struct {
 	Name      string       `json:"name"`
 	Address   string       `json:"address"`
@@ -16,3 +25,20 @@ type Ranking struct {
 	F10kT100k RankingRange `json:"f_10k_t_100k"`
 	F100k     RankingRange `json:"f_100k"`
 }
+
+// CustomRanking is the ranking of the provider with the buckets from the request
+type CustomRanking struct {
+	Name    string          `json:"name"`
+	Address string          `json:"address"`
+	Buckets []RankingBucket `json:"buckets"`
+}
+
+type RankingHistory struct {
+	Day     Time            `json:"day"`
+	Buckets []RankingBucket `json:"buckets"`
+}
+
+type Delegator struct {
+	Address string          `json:"address"`
+	Amount  decimal.Decimal `json:"amount"`
+}
@@ -9,4 +9,5 @@ type ScResult struct {
 	Value   decimal.Decimal `json:"value,omitempty"`
 	Data    string          `json:"data,omitempty"`
 	Message string          `json:"message,omitempty"`
+	Decoded *DecodedData    `json:"decoded,omitempty"`
 }
@@ -13,3 +13,23 @@ type StakeEvent struct {
 	Amount    decimal.Decimal `json:"amount"`
 	CreatedAt Time            `json:"created_at"`
 }
+
+type StakeFlow struct {
+	Day               Time            `json:"day"`
+	Inflow            decimal.Decimal `json:"inflow"`
+	Outflow           decimal.Decimal `json:"outflow"`
+	Redelegated       decimal.Decimal `json:"redelegated"`
+	Withdrawn         decimal.Decimal `json:"withdrawn"`
+	NetFlow           decimal.Decimal `json:"net_flow"`
+	NewDelegators     uint64          `json:"new_delegators"`
+	LeavingDelegators uint64          `json:"leaving_delegators"`
+}
+
+type DelegationDrift struct {
+	Provider  string          `json:"provider"`
+	Delegator string          `json:"delegator"`
+	Parsed    decimal.Decimal `json:"parsed"`
+	OnChain   decimal.Decimal `json:"on_chain"`
+	Drift     decimal.Decimal `json:"drift"`
+	CheckedAt Time            `json:"checked_at"`
+}
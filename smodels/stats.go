@@ -16,6 +16,7 @@ type Stats struct {
 	StakingProviders       uint64          `json:"staking_providers"`
 	AVGStakingProvidersFee decimal.Decimal `json:"avg_staking_providers_fee"`
 	AVGTxFee               decimal.Decimal `json:"avg_tx_fee"`
+	Stake                  StakeBreakdown  `json:"stake"`
 }
 
 type ValidatorStats struct {
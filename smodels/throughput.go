@@ -0,0 +1,25 @@
+package smodels
+
+import "github.com/shopspring/decimal"
+
+type (
+	TPS struct {
+		TPS      decimal.Decimal `json:"tps"`
+		Time     Time            `json:"time"`
+		PeakTPS  decimal.Decimal `json:"peak_tps"`
+		PeakTime Time            `json:"peak_time"`
+	}
+	Throughput struct {
+		Blocks           uint64          `json:"blocks"`
+		EmptyBlocks      uint64          `json:"empty_blocks"`
+		EmptyBlocksRatio decimal.Decimal `json:"empty_blocks_ratio"`
+		AVGBlockSize     decimal.Decimal `json:"avg_block_size"`
+		Txs              uint64          `json:"txs"`
+		TPS              decimal.Decimal `json:"tps"`
+		Time             Time            `json:"time"`
+	}
+	ShardThroughput struct {
+		Shard uint64 `json:"shard"`
+		Throughput
+	}
+)
@@ -7,12 +7,28 @@ import (
 
 type (
 	NFTCollection struct {
-		Name       string          `json:"name"`
-		Identity   string          `json:"identity"`
-		Owner      string          `json:"owner"`
-		Type       string          `json:"type"`
-		Properties json.RawMessage `json:"properties"`
-		CreatedAt  Time            `json:"created_at"`
+		Name       string             `json:"name"`
+		Identity   string             `json:"identity"`
+		Owner      string             `json:"owner"`
+		Type       string             `json:"type"`
+		Properties json.RawMessage    `json:"properties"`
+		CreatedAt  Time               `json:"created_at"`
+		Stats      NFTCollectionStats `json:"stats"`
+	}
+	NFTCollectionStats struct {
+		Items           uint64   `json:"items"`
+		Holders         uint64   `json:"holders"`
+		MintsPerDay     uint64   `json:"mints_per_day"`
+		TransfersPerDay uint64   `json:"transfers_per_day"`
+		Creators        []string `json:"creators"`
+		UpdatedAt       Time     `json:"updated_at"`
+		// Daily is returned only by the stats endpoint of the collection
+		Daily []NFTCollectionDailyStat `json:"daily,omitempty"`
+	}
+	NFTCollectionDailyStat struct {
+		Time      Time   `json:"time"`
+		Mints     uint64 `json:"mints"`
+		Transfers uint64 `json:"transfers"`
 	}
 	NFT struct {
 		Name       string          `json:"name"`
@@ -24,6 +40,37 @@ type (
 		Minted     Time            `json:"minted"`
 		Royalties  decimal.Decimal `json:"royalties"`
 		Assets     json.RawMessage `json:"assets"`
+		Metadata   *NFTMetadata    `json:"metadata,omitempty"`
+		Owners     []NFTOwner      `json:"owners,omitempty"`
+	}
+	NFTOwner struct {
+		Address  string          `json:"address"`
+		Quantity decimal.Decimal `json:"quantity"`
+	}
+	AccountNFTCollection struct {
+		Collection string       `json:"collection"`
+		Name       string       `json:"name"`
+		NFTs       []AccountNFT `json:"nfts"`
+	}
+	AccountNFT struct {
+		Identifier string          `json:"identifier"`
+		Nonce      uint64          `json:"nonce"`
+		Quantity   decimal.Decimal `json:"quantity"`
+	}
+	NFTMetadata struct {
+		Status      string            `json:"status"`
+		Attributes  map[string]string `json:"attributes"`
+		Name        string            `json:"name"`
+		Description string            `json:"description"`
+		Image       string            `json:"image"`
+		Thumbnail   string            `json:"thumbnail"`
+		Traits      []NFTTrait        `json:"traits"`
+		Error       string            `json:"error,omitempty"`
+		UpdatedAt   Time              `json:"updated_at"`
+	}
+	NFTTrait struct {
+		TraitType string `json:"trait_type"`
+		Value     string `json:"value"`
 	}
 	Token struct {
 		Identity   string          `json:"identity"`
@@ -35,6 +82,18 @@ type (
 		Properties json.RawMessage `json:"properties"`
 		Roles      json.RawMessage `json:"roles"`
 	}
+	TokenHolder struct {
+		Address string          `json:"address"`
+		Balance decimal.Decimal `json:"balance"`
+		Share   decimal.Decimal `json:"share"`
+	}
+	TokensSync struct {
+		StartedAt        Time    `json:"started_at"`
+		Duration         float64 `json:"duration"`
+		AvgTokenDuration float64 `json:"avg_token_duration"`
+		Total            uint64  `json:"total"`
+		Failed           uint64  `json:"failed"`
+	}
 	TokenMetaInfo struct {
 		Identifier string          `json:"identifier"`
 		Name       string          `json:"name"`
@@ -17,9 +17,27 @@ type Tx struct {
 	ScResults     []ScResult      `json:"scResults"`
 	Signature     string          `json:"signature"`
 	Data          string          `json:"data"`
+	Decoded       *DecodedData    `json:"decoded,omitempty"`
 	Timestamp     Time            `json:"timestamp"`
 }
 
+type (
+	// DecodedData is the data of the contract call or the result decoded with the contract ABI
+	DecodedData struct {
+		Function   string         `json:"function,omitempty"`
+		Arguments  []DecodedValue `json:"arguments,omitempty"`
+		ReturnCode string         `json:"return_code,omitempty"`
+		Results    []DecodedValue `json:"results,omitempty"`
+		// Call is the contract call made with ESDT transfer
+		Call *DecodedData `json:"call,omitempty"`
+	}
+	DecodedValue struct {
+		Name  string      `json:"name,omitempty"`
+		Type  string      `json:"type"`
+		Value interface{} `json:"value"`
+	}
+)
+
 type (
 	Operation struct {
 		Nonce          uint64            `json:"nonce"`
@@ -34,5 +52,7 @@ type (
 		Tokens         []string          `json:"tokens"`
 		ESDTValues     []decimal.Decimal `json:"esdt_values"`
 		TokensDetails  []TokenMetaInfo   `json:"tokens_details"`
+		Data           string            `json:"data,omitempty"`
+		Decoded        *DecodedData      `json:"decoded,omitempty"`
 	}
 )
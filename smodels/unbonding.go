@@ -0,0 +1,30 @@
+package smodels
+
+import "github.com/shopspring/decimal"
+
+type (
+	Unbonding struct {
+		TxHash            string          `json:"tx_hash"`
+		Type              string          `json:"type"`
+		Provider          string          `json:"provider"`
+		Amount            decimal.Decimal `json:"amount"`
+		Epoch             uint64          `json:"epoch"`
+		WithdrawableEpoch uint64          `json:"withdrawable_epoch"`
+		Withdrawable      bool            `json:"withdrawable"`
+		CreatedAt         Time            `json:"created_at"`
+	}
+	AccountUnbonding struct {
+		Unbonding    decimal.Decimal `json:"unbonding"`
+		Withdrawable decimal.Decimal `json:"withdrawable"`
+		Items        []Unbonding     `json:"items"`
+	}
+	UnbondingRelease struct {
+		Epoch  uint64          `json:"epoch"`
+		Amount decimal.Decimal `json:"amount"`
+	}
+	UnbondingStats struct {
+		Epoch    uint64             `json:"epoch"`
+		Total    decimal.Decimal    `json:"total"`
+		Releases []UnbondingRelease `json:"releases"`
+	}
+)
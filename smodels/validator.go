@@ -3,19 +3,21 @@ package smodels
 import "github.com/shopspring/decimal"
 
 type Identity struct {
-	Identity     string          `json:"identity"`
-	Name         string          `json:"name"`
-	Avatar       string          `json:"avatar"`
-	Description  string          `json:"description"`
-	Locked       decimal.Decimal `json:"locked"`
-	Rank         uint64          `json:"rank"`
-	Score        uint64          `json:"score"`
-	Stake        decimal.Decimal `json:"stake"`
-	StakePercent float64         `json:"stake_percent"`
-	TopUp        decimal.Decimal `json:"top_up"`
-	Validators   uint64          `json:"validators"`
-	AVGUptime    float64         `json:"avg_uptime"`
-	Providers    []string        `json:"providers"`
+	Identity     string           `json:"identity"`
+	Name         string           `json:"name"`
+	Avatar       string           `json:"avatar"`
+	Description  string           `json:"description"`
+	Website      string           `json:"website,omitempty"`
+	Locked       decimal.Decimal  `json:"locked"`
+	Rank         uint64           `json:"rank"`
+	Score        uint64           `json:"score"`
+	Stake        decimal.Decimal  `json:"stake"`
+	StakePercent float64          `json:"stake_percent"`
+	TopUp        decimal.Decimal  `json:"top_up"`
+	Validators   uint64           `json:"validators"`
+	AVGUptime    float64          `json:"avg_uptime"`
+	Providers    []string         `json:"providers"`
+	Epochs       []ValidatorEpoch `json:"epochs,omitempty"`
 }
 
 type StakingProvider struct {
@@ -23,7 +25,10 @@ type StakingProvider struct {
 	ServiceFee       decimal.Decimal          `json:"service_fee"`
 	DelegationCap    decimal.Decimal          `json:"delegation_cap"`
 	APR              decimal.Decimal          `json:"apr"`
+	SourceAPR        decimal.Decimal          `json:"source_apr"`
+	RealisedAPR      RealisedAPR              `json:"realised_apr"`
 	NumUsers         uint64                   `json:"num_users"`
+	UnBondPeriod     uint64                   `json:"unbond_period"`
 	CumulatedRewards decimal.Decimal          `json:"cumulated_rewards"`
 	Identity         string                   `json:"identity"`
 	Name             string                   `json:"name"`
@@ -36,6 +41,13 @@ type StakingProvider struct {
 	Validator        StakingProviderValidator `json:"validator"`
 }
 
+// RealisedAPR is the annualised return of the rewards distributed to the delegators within the last days
+type RealisedAPR struct {
+	Week    decimal.Decimal `json:"7d"`
+	Month   decimal.Decimal `json:"30d"`
+	Quarter decimal.Decimal `json:"90d"`
+}
+
 type StakingProviderValidator struct {
 	Name         string          `json:"name"`
 	Locked       decimal.Decimal `json:"locked"`
@@ -76,64 +88,19 @@ type SourceStakingProvider struct {
 	OwnerBelowRequiredBalanceThreshold bool            `json:"ownerBelowRequiredBalanceThreshold"`
 }
 
-type IdentityKeybase struct {
-	Status struct {
-		Code int    `json:"code"`
-		Name string `json:"name"`
-	} `json:"status"`
-	Them struct {
-		ID     string `json:"id"`
-		Basics struct {
-			Username      string `json:"username"`
-			Ctime         int    `json:"ctime"`
-			Mtime         int    `json:"mtime"`
-			IDVersion     int    `json:"id_version"`
-			TrackVersion  int    `json:"track_version"`
-			LastIDChange  int    `json:"last_id_change"`
-			UsernameCased string `json:"username_cased"`
-			Status        int    `json:"status"`
-			Salt          string `json:"salt"`
-			EldestSeqno   int    `json:"eldest_seqno"`
-		} `json:"basics"`
-		Profile struct {
-			Mtime    interface{} `json:"mtime"`
-			FullName string      `json:"full_name"`
-			Location interface{} `json:"location"`
-			Bio      string      `json:"bio"`
-		} `json:"profile"`
-		PublicKeys struct {
-			AllBundles           []string `json:"all_bundles"`
-			Subkeys              []string `json:"subkeys"`
-			Sibkeys              []string `json:"sibkeys"`
-			EldestKid            string   `json:"eldest_kid"`
-			EldestKeyFingerprint string   `json:"eldest_key_fingerprint"`
-		} `json:"public_keys"`
-		ProofsSummary struct {
-			ByPresentationGroup struct {
-			} `json:"by_presentation_group"`
-			BySigID struct {
-			} `json:"by_sig_id"`
-			HasWeb bool `json:"has_web"`
-		} `json:"proofs_summary"`
-		CryptocurrencyAddresses struct {
-		} `json:"cryptocurrency_addresses"`
-		Pictures struct {
-			Primary struct {
-				URL    string      `json:"url"`
-				Source interface{} `json:"source"`
-			} `json:"primary"`
-		} `json:"pictures"`
-		Sigs struct {
-			Last struct {
-				SigID       string `json:"sig_id"`
-				Seqno       int    `json:"seqno"`
-				PayloadHash string `json:"payload_hash"`
-			} `json:"last"`
-		} `json:"sigs"`
-		Stellar struct {
-			Hidden  bool `json:"hidden"`
-			Primary struct {
-			} `json:"primary"`
-		} `json:"stellar"`
-	} `json:"them"`
+// IdentityProfile is the profile of the validator identity set by the admin
+type IdentityProfile struct {
+	Name        string `json:"name"`
+	Avatar      string `json:"avatar"`
+	Description string `json:"description"`
+	Website     string `json:"website"`
+}
+
+type StakingCalculation struct {
+	Provider string          `json:"provider,omitempty"`
+	Amount   decimal.Decimal `json:"amount"`
+	APR      decimal.Decimal `json:"apr"`
+	Daily    decimal.Decimal `json:"daily"`
+	Monthly  decimal.Decimal `json:"monthly"`
+	Yearly   decimal.Decimal `json:"yearly"`
 }